@@ -0,0 +1,79 @@
+package duo
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAccountSummary() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAccountSummaryRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"admin_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"integration_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"telephony_credits_remaining": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"user_pending_deletion_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type AccountSummary struct {
+	UserCount                 int `json:"user_count"`
+	AdminCount                int `json:"admin_count"`
+	IntegrationCount          int `json:"integration_count"`
+	TelephonyCreditsRemaining int `json:"telephony_credits_remaining"`
+	UserPendingDeletionCount  int `json:"user_pending_deletion_count"`
+}
+
+type AccountSummaryResult struct {
+	duoapi.StatResult
+	Response AccountSummary
+}
+
+func dataSourceAccountSummaryRead(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	_, body, err := duoAdminClient.SignedCall("GET", "/admin/v1/info/summary", nil, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+
+	result := &AccountSummaryResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("could not read account summary from duo %s, %s", result.Stat, *result.Message)
+	}
+
+	d.SetId("account_summary")
+	d.Set("user_count", result.Response.UserCount)
+	d.Set("admin_count", result.Response.AdminCount)
+	d.Set("integration_count", result.Response.IntegrationCount)
+	d.Set("telephony_credits_remaining", result.Response.TelephonyCreditsRemaining)
+	d.Set("user_pending_deletion_count", result.Response.UserPendingDeletionCount)
+	return nil
+}
@@ -0,0 +1,37 @@
+package duo
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAccountSummary_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckDataSourceAccountSummaryConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.duo_account_summary.test", "user_count"),
+					resource.TestCheckResourceAttrSet(
+						"data.duo_account_summary.test", "admin_count"),
+					resource.TestCheckResourceAttrSet(
+						"data.duo_account_summary.test", "integration_count"),
+					resource.TestCheckResourceAttrSet(
+						"data.duo_account_summary.test", "telephony_credits_remaining"),
+					resource.TestCheckResourceAttrSet(
+						"data.duo_account_summary.test", "user_pending_deletion_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceAccountSummaryConfig() string {
+	return `
+data "duo_account_summary" "test" {}
+`
+}
@@ -0,0 +1,205 @@
+package duo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// administratorLogPageSize is the fixed number of records the v1
+// administrator log API returns per request.
+const administratorLogPageSize = 1000
+
+func dataSourceAdministratorLogs() *schema.Resource {
+	s := logWindowSchema()
+	s["actions"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	s["objects"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	s["logs"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timestamp": {Type: schema.TypeString, Computed: true},
+				"action":    {Type: schema.TypeString, Computed: true},
+				"object":    {Type: schema.TypeString, Computed: true},
+				"username":  {Type: schema.TypeString, Computed: true},
+				"description": {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceAdministratorLogsRead,
+		Schema: s,
+	}
+}
+
+type AdministratorLog struct {
+	Action      string `json:"action"`
+	Description string `json:"description"`
+	Object      string `json:"object"`
+	Timestamp   int64  `json:"timestamp"`
+	Username    string `json:"username"`
+}
+
+type AdministratorLogsResult struct {
+	duoapi.StatResult
+	Response []AdministratorLog
+}
+
+// descriptionMap parses the JSON encoded description of an administrator
+// log entry into a flat map of strings.
+func (l AdministratorLog) descriptionMap() map[string]interface{} {
+	description := map[string]interface{}{}
+	if l.Description == "" {
+		return description
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(l.Description), &raw); err != nil {
+		description["description"] = l.Description
+		return description
+	}
+	for k, v := range raw {
+		switch v := v.(type) {
+		case string:
+			description[k] = v
+		case nil:
+			description[k] = ""
+		default:
+			b, _ := json.Marshal(v)
+			description[k] = string(b)
+		}
+	}
+	return description
+}
+
+// fetchAdministratorLogs retrieves the administrator log entries within
+// the window that keep accepts, or every entry when keep is nil. Entries
+// are filtered before they count towards maxResults, so truncated reports
+// whether matching entries were left unread. The v1 API only accepts a
+// mintime, so pages are walked by advancing mintime to the last timestamp
+// seen and skipping the entries already retrieved at that second.
+func fetchAdministratorLogs(duoAdminClient *admin.Client, mintime, maxtime time.Time, maxResults int, keep func(AdministratorLog) bool) ([]AdministratorLog, bool, error) {
+	maxSeconds := maxtime.Unix()
+
+	var logs []AdministratorLog
+	offset := mintime.Unix()
+	seenAtOffset := 0
+	for {
+		params := url.Values{}
+		params.Set("mintime", strconv.FormatInt(offset, 10))
+
+		_, body, err := duoAdminClient.SignedCall("GET", "/admin/v1/logs/administrator", params, duoapi.UseTimeout)
+		if err != nil {
+			return nil, false, err
+		}
+
+		result := &AdministratorLogsResult{}
+		err = json.Unmarshal(body, result)
+		if err != nil {
+			return nil, false, err
+		}
+		if result.Stat != "OK" {
+			return nil, false, fmt.Errorf("could not read administrator logs from duo %s, %s", result.Stat, *result.Message)
+		}
+
+		page := result.Response
+		skip := 0
+		for skip < seenAtOffset && skip < len(page) && page[skip].Timestamp == offset {
+			skip++
+		}
+
+		exhausted := len(page) < administratorLogPageSize || skip == len(page)
+		for _, l := range page[skip:] {
+			if l.Timestamp > maxSeconds {
+				exhausted = true
+				break
+			}
+			if keep != nil && !keep(l) {
+				continue
+			}
+			if len(logs) == maxResults {
+				return logs, true, nil
+			}
+			logs = append(logs, l)
+		}
+		if exhausted {
+			return logs, false, nil
+		}
+
+		last := page[len(page)-1].Timestamp
+		seenAtOffset = 0
+		for i := len(page) - 1; i >= 0 && page[i].Timestamp == last; i-- {
+			seenAtOffset++
+		}
+		offset = last
+	}
+}
+
+func stringInList(s string, list []interface{}) bool {
+	for _, v := range list {
+		if v.(string) == s {
+			return true
+		}
+	}
+	return false
+}
+
+func dataSourceAdministratorLogsRead(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	mintime, maxtime, err := logWindow(d)
+	if err != nil {
+		return err
+	}
+
+	actions := d.Get("actions").([]interface{})
+	objects := d.Get("objects").([]interface{})
+	logs, truncated, err := fetchAdministratorLogs(duoAdminClient, mintime, maxtime, d.Get("max_results").(int), func(l AdministratorLog) bool {
+		return (len(actions) == 0 || stringInList(l.Action, actions)) &&
+			(len(objects) == 0 || stringInList(l.Object, objects))
+	})
+	if err != nil {
+		return err
+	}
+
+	flattened := make([]map[string]interface{}, 0, len(logs))
+	for _, l := range logs {
+		flattened = append(flattened, map[string]interface{}{
+			"timestamp":   secondsToTime(l.Timestamp),
+			"action":      l.Action,
+			"object":      l.Object,
+			"username":    l.Username,
+			"description": l.descriptionMap(),
+		})
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(fmt.Sprintf("%d-%d-%v-%v", mintime.Unix(), maxtime.Unix(), actions, objects))))
+	d.Set("maxtime", maxtime.Format(time.RFC3339))
+	d.Set("truncated", truncated)
+	if err := d.Set("logs", flattened); err != nil {
+		return err
+	}
+	return nil
+}
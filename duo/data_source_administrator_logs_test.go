@@ -0,0 +1,118 @@
+package duo
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAdministratorLogs_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckDataSourceAdministratorLogsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.duo_administrator_logs.test", "maxtime", "2019-01-08T00:00:00Z"),
+					resource.TestCheckResourceAttrSet(
+						"data.duo_administrator_logs.test", "logs.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAdministratorLogDescriptionMap(t *testing.T) {
+	cases := []struct {
+		Description string
+		Expected    map[string]interface{}
+	}{
+		{
+			Description: "",
+			Expected:    map[string]interface{}{},
+		},
+		{
+			Description: `{"realname": "Joe Smith", "status": "Active", "phones": ["+18005551234"], "notes": null}`,
+			Expected: map[string]interface{}{
+				"realname": "Joe Smith",
+				"status":   "Active",
+				"phones":   `["+18005551234"]`,
+				"notes":    "",
+			},
+		},
+		{
+			Description: "not json",
+			Expected: map[string]interface{}{
+				"description": "not json",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		l := AdministratorLog{Description: tc.Description}
+		if actual := l.descriptionMap(); !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("description %q: expected %#v, got %#v", tc.Description, tc.Expected, actual)
+		}
+	}
+}
+
+func TestFetchAdministratorLogs_filtered(t *testing.T) {
+	// A full first page of unrelated entries, followed by a page starting
+	// with the last entry already seen and then the matching entries.
+	first := make([]AdministratorLog, administratorLogPageSize)
+	for i := range first {
+		first[i] = AdministratorLog{Action: "user_update", Object: "someone", Timestamp: int64(100 + i)}
+	}
+	last := first[len(first)-1]
+	second := []AdministratorLog{
+		last,
+		AdministratorLog{Action: "phone_update", Object: "+18005551234", Timestamp: last.Timestamp + 1},
+		AdministratorLog{Action: "phone_update", Object: "+18005551234", Timestamp: last.Timestamp + 2},
+	}
+
+	client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+		page := first
+		if r.URL.Query().Get("mintime") != "100" {
+			page = second
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"stat": "OK", "response": page})
+	})
+	defer closeServer()
+
+	keep := func(l AdministratorLog) bool { return l.Object == "+18005551234" }
+	cases := []struct {
+		maxResults int
+		found      int
+		truncated  bool
+	}{
+		{5, 2, false},
+		{2, 2, false},
+		{1, 1, true},
+	}
+	for _, c := range cases {
+		logs, truncated, err := fetchAdministratorLogs(admin.New(*client), time.Unix(100, 0), time.Unix(5000, 0), c.maxResults, keep)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(logs) != c.found || truncated != c.truncated {
+			t.Errorf("max_results %d: expected %d entries (truncated %t), got %d (truncated %t)", c.maxResults, c.found, c.truncated, len(logs), truncated)
+		}
+	}
+}
+
+func testAccCheckDataSourceAdministratorLogsConfig() string {
+	return `
+data "duo_administrator_logs" "test" {
+  mintime = "2019-01-01T00:00:00Z"
+  maxtime = "2019-01-08T00:00:00Z"
+  actions = ["user_update", "phone_update"]
+}
+`
+}
@@ -0,0 +1,201 @@
+package duo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// authenticationLogFilters maps the data source's list arguments to the
+// repeatable query parameters understood by the authentication log API.
+var authenticationLogFilters = []string{
+	"users",
+	"groups",
+	"applications",
+	"results",
+	"reasons",
+	"factors",
+}
+
+func dataSourceAuthenticationLogs() *schema.Resource {
+	s := logWindowSchema()
+	for _, filter := range authenticationLogFilters {
+		s[filter] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+	}
+	s["logs"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"txid":                   {Type: schema.TypeString, Computed: true},
+				"timestamp":              {Type: schema.TypeString, Computed: true},
+				"event_type":             {Type: schema.TypeString, Computed: true},
+				"factor":                 {Type: schema.TypeString, Computed: true},
+				"reason":                 {Type: schema.TypeString, Computed: true},
+				"result":                 {Type: schema.TypeString, Computed: true},
+				"user_id":                {Type: schema.TypeString, Computed: true},
+				"username":               {Type: schema.TypeString, Computed: true},
+				"application_key":        {Type: schema.TypeString, Computed: true},
+				"application_name":       {Type: schema.TypeString, Computed: true},
+				"access_device_ip":       {Type: schema.TypeString, Computed: true},
+				"access_device_hostname": {Type: schema.TypeString, Computed: true},
+				"access_device_os":       {Type: schema.TypeString, Computed: true},
+				"access_device_browser":  {Type: schema.TypeString, Computed: true},
+				"access_device_city":     {Type: schema.TypeString, Computed: true},
+				"access_device_state":    {Type: schema.TypeString, Computed: true},
+				"access_device_country":  {Type: schema.TypeString, Computed: true},
+				"auth_device_name":       {Type: schema.TypeString, Computed: true},
+				"auth_device_ip":         {Type: schema.TypeString, Computed: true},
+				"auth_device_city":       {Type: schema.TypeString, Computed: true},
+				"auth_device_state":      {Type: schema.TypeString, Computed: true},
+				"auth_device_country":    {Type: schema.TypeString, Computed: true},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceAuthenticationLogsRead,
+		Schema: s,
+	}
+}
+
+type LogLocation struct {
+	City    string `json:"city"`
+	State   string `json:"state"`
+	Country string `json:"country"`
+}
+
+type AuthenticationLog struct {
+	TxID      string `json:"txid"`
+	Timestamp int64  `json:"timestamp"`
+	EventType string `json:"event_type"`
+	Factor    string `json:"factor"`
+	Reason    string `json:"reason"`
+	Result    string `json:"result"`
+	User      struct {
+		Key  string `json:"key"`
+		Name string `json:"name"`
+	} `json:"user"`
+	Application struct {
+		Key  string `json:"key"`
+		Name string `json:"name"`
+	} `json:"application"`
+	AccessDevice struct {
+		IP       string      `json:"ip"`
+		Hostname string      `json:"hostname"`
+		OS       string      `json:"os"`
+		Browser  string      `json:"browser"`
+		Location LogLocation `json:"location"`
+	} `json:"access_device"`
+	AuthDevice struct {
+		Name     string      `json:"name"`
+		IP       string      `json:"ip"`
+		Location LogLocation `json:"location"`
+	} `json:"auth_device"`
+}
+
+type AuthenticationLogsResult struct {
+	duoapi.StatResult
+	Response struct {
+		AuthLogs []AuthenticationLog `json:"authlogs"`
+		Metadata struct {
+			NextOffset []string `json:"next_offset"`
+		} `json:"metadata"`
+	}
+}
+
+func dataSourceAuthenticationLogsRead(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	mintime, maxtime, err := logWindow(d)
+	if err != nil {
+		return err
+	}
+	maxResults := d.Get("max_results").(int)
+
+	params := url.Values{}
+	params.Set("mintime", strconv.FormatInt(unixMillis(mintime), 10))
+	params.Set("maxtime", strconv.FormatInt(unixMillis(maxtime), 10))
+	for _, filter := range authenticationLogFilters {
+		for _, v := range d.Get(filter).([]interface{}) {
+			params.Add(filter, v.(string))
+		}
+	}
+	id := hashcode.String(params.Encode())
+
+	var logs []AuthenticationLog
+	truncated, err := paginateLogs(maxResults, func(limit int, offset string) (int, string, error) {
+		params.Set("limit", strconv.Itoa(limit))
+		if offset != "" {
+			params.Set("next_offset", offset)
+		}
+		_, body, err := duoAdminClient.SignedCall("GET", "/admin/v2/logs/authentication", params, duoapi.UseTimeout)
+		if err != nil {
+			return 0, "", err
+		}
+
+		result := &AuthenticationLogsResult{}
+		err = json.Unmarshal(body, result)
+		if err != nil {
+			return 0, "", err
+		}
+		if result.Stat != "OK" {
+			return 0, "", fmt.Errorf("could not read authentication logs from duo %s, %s", result.Stat, *result.Message)
+		}
+
+		logs = append(logs, result.Response.AuthLogs...)
+		return len(result.Response.AuthLogs), strings.Join(result.Response.Metadata.NextOffset, ","), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	flattened := make([]map[string]interface{}, 0, len(logs))
+	for _, l := range logs {
+		flattened = append(flattened, map[string]interface{}{
+			"txid":                   l.TxID,
+			"timestamp":              secondsToTime(l.Timestamp),
+			"event_type":             l.EventType,
+			"factor":                 l.Factor,
+			"reason":                 l.Reason,
+			"result":                 l.Result,
+			"user_id":                l.User.Key,
+			"username":               l.User.Name,
+			"application_key":        l.Application.Key,
+			"application_name":       l.Application.Name,
+			"access_device_ip":       l.AccessDevice.IP,
+			"access_device_hostname": l.AccessDevice.Hostname,
+			"access_device_os":       l.AccessDevice.OS,
+			"access_device_browser":  l.AccessDevice.Browser,
+			"access_device_city":     l.AccessDevice.Location.City,
+			"access_device_state":    l.AccessDevice.Location.State,
+			"access_device_country":  l.AccessDevice.Location.Country,
+			"auth_device_name":       l.AuthDevice.Name,
+			"auth_device_ip":         l.AuthDevice.IP,
+			"auth_device_city":       l.AuthDevice.Location.City,
+			"auth_device_state":      l.AuthDevice.Location.State,
+			"auth_device_country":    l.AuthDevice.Location.Country,
+		})
+	}
+
+	d.SetId(strconv.Itoa(id))
+	d.Set("maxtime", maxtime.Format(time.RFC3339))
+	d.Set("truncated", truncated)
+	if err := d.Set("logs", flattened); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package duo
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAuthenticationLogs_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckDataSourceAuthenticationLogsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.duo_authentication_logs.test", "maxtime", "2019-01-08T00:00:00Z"),
+					resource.TestCheckResourceAttrSet(
+						"data.duo_authentication_logs.test", "logs.#"),
+					resource.TestCheckResourceAttrSet(
+						"data.duo_authentication_logs.test", "truncated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAuthenticationLogs_invalidWindow(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccCheckDataSourceAuthenticationLogsConfigInvalid(),
+				ExpectError: regexp.MustCompile("must be after mintime"),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceAuthenticationLogsConfig() string {
+	return `
+data "duo_authentication_logs" "test" {
+  mintime = "2019-01-01T00:00:00Z"
+  maxtime = "2019-01-08T00:00:00Z"
+  results = ["success"]
+  factors = ["duo_push", "phone_call"]
+  max_results = 50
+}
+`
+}
+
+func testAccCheckDataSourceAuthenticationLogsConfigInvalid() string {
+	return `
+data "duo_authentication_logs" "test" {
+  mintime = "2019-01-08T00:00:00Z"
+  maxtime = "2019-01-01T00:00:00Z"
+}
+`
+}
@@ -0,0 +1,89 @@
+package duo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTelephonyCredits() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTelephonyCreditsRead,
+
+		Schema: map[string]*schema.Schema{
+			"mintime": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateRFC3339,
+			},
+			"maxtime": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateRFC3339,
+			},
+			"telephony_credits_used": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type TelephonyCredits struct {
+	MinTime              int64 `json:"mintime"`
+	MaxTime              int64 `json:"maxtime"`
+	TelephonyCreditsUsed int   `json:"telephony_credits_used"`
+}
+
+type TelephonyCreditsResult struct {
+	duoapi.StatResult
+	Response TelephonyCredits
+}
+
+func dataSourceTelephonyCreditsRead(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	// Duo defaults to the last thirty days when no window is given
+	params := url.Values{}
+	if mintime, ok := d.GetOk("mintime"); ok {
+		ms, err := timeToMillis(mintime.(string))
+		if err != nil {
+			return err
+		}
+		params.Set("mintime", ms)
+	}
+	if maxtime, ok := d.GetOk("maxtime"); ok {
+		ms, err := timeToMillis(maxtime.(string))
+		if err != nil {
+			return err
+		}
+		params.Set("maxtime", ms)
+	}
+
+	_, body, err := duoAdminClient.SignedCall("GET", "/admin/v1/info/telephony_credits_used", params, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+
+	result := &TelephonyCreditsResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("could not read telephony credits from duo %s, %s", result.Stat, *result.Message)
+	}
+
+	d.SetId(fmt.Sprintf("telephony_credits_%d_%d", result.Response.MinTime, result.Response.MaxTime))
+	d.Set("mintime", millisToTime(result.Response.MinTime))
+	d.Set("maxtime", millisToTime(result.Response.MaxTime))
+	d.Set("telephony_credits_used", result.Response.TelephonyCreditsUsed)
+	return nil
+}
@@ -0,0 +1,36 @@
+package duo
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceTelephonyCredits_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckDataSourceTelephonyCreditsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.duo_telephony_credits.test", "mintime", "2019-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(
+						"data.duo_telephony_credits.test", "maxtime", "2019-01-31T00:00:00Z"),
+					resource.TestCheckResourceAttrSet(
+						"data.duo_telephony_credits.test", "telephony_credits_used"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceTelephonyCreditsConfig() string {
+	return `
+data "duo_telephony_credits" "test" {
+  mintime = "2019-01-01T00:00:00Z"
+  maxtime = "2019-01-31T00:00:00Z"
+}
+`
+}
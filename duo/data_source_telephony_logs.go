@@ -0,0 +1,126 @@
+package duo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTelephonyLogs() *schema.Resource {
+	s := logWindowSchema()
+	s["logs"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"telephony_id": {Type: schema.TypeString, Computed: true},
+				"txid":         {Type: schema.TypeString, Computed: true},
+				"timestamp":    {Type: schema.TypeString, Computed: true},
+				"context":      {Type: schema.TypeString, Computed: true},
+				"type":         {Type: schema.TypeString, Computed: true},
+				"phone":        {Type: schema.TypeString, Computed: true},
+				"credits":      {Type: schema.TypeInt, Computed: true},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceTelephonyLogsRead,
+		Schema: s,
+	}
+}
+
+type TelephonyLog struct {
+	TelephonyID string `json:"telephony_id"`
+	TxID        string `json:"txid"`
+	Timestamp   string `json:"ts"`
+	Context     string `json:"context"`
+	Type        string `json:"type"`
+	Phone       string `json:"phone"`
+	Credits     int    `json:"credits"`
+}
+
+type TelephonyLogsResult struct {
+	duoapi.StatResult
+	Response struct {
+		Items    []TelephonyLog `json:"items"`
+		Metadata struct {
+			NextOffset string `json:"next_offset"`
+		} `json:"metadata"`
+	}
+}
+
+func dataSourceTelephonyLogsRead(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	mintime, maxtime, err := logWindow(d)
+	if err != nil {
+		return err
+	}
+	maxResults := d.Get("max_results").(int)
+
+	params := url.Values{}
+	params.Set("mintime", strconv.FormatInt(unixMillis(mintime), 10))
+	params.Set("maxtime", strconv.FormatInt(unixMillis(maxtime), 10))
+	id := hashcode.String(params.Encode())
+
+	var logs []TelephonyLog
+	truncated, err := paginateLogs(maxResults, func(limit int, offset string) (int, string, error) {
+		params.Set("limit", strconv.Itoa(limit))
+		if offset != "" {
+			params.Set("next_offset", offset)
+		}
+		_, body, err := duoAdminClient.SignedCall("GET", "/admin/v2/logs/telephony", params, duoapi.UseTimeout)
+		if err != nil {
+			return 0, "", err
+		}
+
+		result := &TelephonyLogsResult{}
+		err = json.Unmarshal(body, result)
+		if err != nil {
+			return 0, "", err
+		}
+		if result.Stat != "OK" {
+			return 0, "", fmt.Errorf("could not read telephony logs from duo %s, %s", result.Stat, *result.Message)
+		}
+
+		logs = append(logs, result.Response.Items...)
+		return len(result.Response.Items), result.Response.Metadata.NextOffset, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	flattened := make([]map[string]interface{}, 0, len(logs))
+	for _, l := range logs {
+		timestamp := l.Timestamp
+		if t, err := time.Parse(time.RFC3339Nano, l.Timestamp); err == nil {
+			timestamp = t.UTC().Format(time.RFC3339)
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"telephony_id": l.TelephonyID,
+			"txid":         l.TxID,
+			"timestamp":    timestamp,
+			"context":      l.Context,
+			"type":         l.Type,
+			"phone":        l.Phone,
+			"credits":      l.Credits,
+		})
+	}
+
+	d.SetId(strconv.Itoa(id))
+	d.Set("maxtime", maxtime.Format(time.RFC3339))
+	d.Set("truncated", truncated)
+	if err := d.Set("logs", flattened); err != nil {
+		return err
+	}
+	return nil
+}
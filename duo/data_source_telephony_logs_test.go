@@ -0,0 +1,35 @@
+package duo
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceTelephonyLogs_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckDataSourceTelephonyLogsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.duo_telephony_logs.test", "maxtime", "2019-01-08T00:00:00Z"),
+					resource.TestCheckResourceAttrSet(
+						"data.duo_telephony_logs.test", "logs.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceTelephonyLogsConfig() string {
+	return `
+data "duo_telephony_logs" "test" {
+  mintime = "2019-01-01T00:00:00Z"
+  maxtime = "2019-01-08T00:00:00Z"
+  max_results = 100
+}
+`
+}
@@ -0,0 +1,76 @@
+package duo
+
+import (
+	"fmt"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceU2FTokens() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceU2FTokensRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"u2ftokens": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"registration_id": {Type: schema.TypeString, Computed: true},
+						"date_added":      {Type: schema.TypeString, Computed: true},
+						"user_id":         {Type: schema.TypeString, Computed: true},
+						"username":        {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceU2FTokensRead(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	var result *admin.GetU2FTokensResult
+	var err error
+	userID := d.Get("user_id").(string)
+	if userID != "" {
+		result, err = duoAdminClient.GetUserU2FTokens(userID)
+	} else {
+		result, err = duoAdminClient.GetU2FTokens()
+	}
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("could not read u2f tokens from duo %s, %s", result.Stat, *result.Message)
+	}
+
+	tokens := make([]map[string]interface{}, 0, len(result.Response))
+	for _, t := range result.Response {
+		token := map[string]interface{}{
+			"registration_id": t.RegistrationID,
+			"date_added":      secondsToTime(int64(t.DateAdded)),
+			"user_id":         userID,
+		}
+		if t.User != nil {
+			token["user_id"] = t.User.UserID
+			token["username"] = t.User.Username
+		}
+		tokens = append(tokens, token)
+	}
+
+	if userID != "" {
+		d.SetId(userID)
+	} else {
+		d.SetId("u2f_tokens")
+	}
+	d.Set("u2ftokens", tokens)
+	return nil
+}
@@ -0,0 +1,43 @@
+package duo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceU2FTokens_Basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckDataSourceU2FTokensConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.duo_u2f_tokens.all", "u2ftokens.#"),
+					resource.TestCheckResourceAttr(
+						"data.duo_u2f_tokens.user", "u2ftokens.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceU2FTokensConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "duo_user" "test" {
+  username = "test-user-%d"
+}
+
+data "duo_u2f_tokens" "all" {}
+
+data "duo_u2f_tokens" "user" {
+  user_id = "${duo_user.test.id}"
+}
+`, rInt)
+}
@@ -0,0 +1,38 @@
+package duo
+
+import (
+	"fmt"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceUserWebAuthnCredentials() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUserWebAuthnCredentialsRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"webauthncredentials": webAuthnCredentialsSchema(),
+		},
+	}
+}
+
+func dataSourceUserWebAuthnCredentialsRead(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	userID := d.Get("user_id").(string)
+	credentials, err := getWebAuthnCredentials(duoAdminClient, fmt.Sprintf("/admin/v1/users/%s/webauthncredentials", userID))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(userID)
+	d.Set("webauthncredentials", flattenOwnedWebAuthnCredentials(credentials, userID))
+	return nil
+}
@@ -0,0 +1,39 @@
+package duo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceUserWebAuthnCredentials_Basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckDataSourceUserWebAuthnCredentialsConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.duo_user_webauthn_credentials.test", "webauthncredentials.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceUserWebAuthnCredentialsConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "duo_user" "test" {
+  username = "test-user-%d"
+}
+
+data "duo_user_webauthn_credentials" "test" {
+  user_id = "${duo_user.test.id}"
+}
+`, rInt)
+}
@@ -0,0 +1,103 @@
+package duo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceWebAuthnCredentials() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceWebAuthnCredentialsRead,
+
+		Schema: map[string]*schema.Schema{
+			"webauthncredentials": webAuthnCredentialsSchema(),
+		},
+	}
+}
+
+func webAuthnCredentialsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"webauthnkey":     {Type: schema.TypeString, Computed: true},
+				"credential_name": {Type: schema.TypeString, Computed: true},
+				"label":           {Type: schema.TypeString, Computed: true},
+				"date_added":      {Type: schema.TypeString, Computed: true},
+				"user_id":         {Type: schema.TypeString, Computed: true},
+				"username":        {Type: schema.TypeString, Computed: true},
+			},
+		},
+	}
+}
+
+type WebAuthnCredentialsResult struct {
+	duoapi.StatResult
+	admin.ListResult
+	Response []WebAuthnCredential
+}
+
+// getWebAuthnCredentials pages through the WebAuthn credentials listed at
+// path.
+func getWebAuthnCredentials(duoAdminClient *admin.Client, path string) ([]WebAuthnCredential, error) {
+	credentials := []WebAuthnCredential{}
+	params := url.Values{}
+	params.Set("limit", "500")
+	params.Set("offset", "0")
+	for {
+		_, body, err := duoAdminClient.SignedCall("GET", path, params, duoapi.UseTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		result := &WebAuthnCredentialsResult{}
+		err = json.Unmarshal(body, result)
+		if err != nil {
+			return nil, err
+		}
+		if result.Stat != "OK" {
+			return nil, fmt.Errorf("could not read webauthn credentials from duo %s, %s", result.Stat, *result.Message)
+		}
+		credentials = append(credentials, result.Response...)
+
+		nextOffset := result.Metadata.NextOffset.String()
+		if nextOffset == "" {
+			return credentials, nil
+		}
+		params.Set("offset", nextOffset)
+	}
+}
+
+// flattenOwnedWebAuthnCredentials flattens credentials along with their
+// owners, falling back to userID when the owner isn't included.
+func flattenOwnedWebAuthnCredentials(credentials []WebAuthnCredential, userID string) []map[string]interface{} {
+	flattened := flattenWebAuthnCredentials(credentials)
+	for i, c := range credentials {
+		flattened[i]["user_id"] = userID
+		if c.User != nil {
+			flattened[i]["user_id"] = c.User.UserID
+			flattened[i]["username"] = c.User.Username
+		}
+	}
+	return flattened
+}
+
+func dataSourceWebAuthnCredentialsRead(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	credentials, err := getWebAuthnCredentials(duoAdminClient, "/admin/v1/webauthncredentials")
+	if err != nil {
+		return err
+	}
+
+	d.SetId("webauthn_credentials")
+	d.Set("webauthncredentials", flattenOwnedWebAuthnCredentials(credentials, ""))
+	return nil
+}
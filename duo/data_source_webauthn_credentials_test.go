@@ -0,0 +1,63 @@
+package duo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceWebAuthnCredentials_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckDataSourceWebAuthnCredentialsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.duo_webauthn_credentials.test", "webauthncredentials.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetWebAuthnCredentials(t *testing.T) {
+	client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/v1/webauthncredentials" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		// The credentials are returned over two pages to exercise pagination.
+		if r.URL.Query().Get("offset") == "0" {
+			fmt.Fprint(w, `{"stat": "OK", "response": [{"webauthnkey": "WA1", "label": "one", "date_added": 1550000000, "user": {"user_id": "DU1", "username": "one"}}], "metadata": {"next_offset": 1}}`)
+			return
+		}
+		fmt.Fprint(w, `{"stat": "OK", "response": [{"webauthnkey": "WA2", "label": "two", "date_added": 1550000000}], "metadata": {}}`)
+	})
+	defer closeServer()
+
+	credentials, err := getWebAuthnCredentials(admin.New(*client), "/admin/v1/webauthncredentials")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(credentials) != 2 {
+		t.Fatalf("expected 2 credentials, got %d", len(credentials))
+	}
+
+	flattened := flattenOwnedWebAuthnCredentials(credentials, "DU2")
+	if flattened[0]["username"] != "one" || flattened[0]["user_id"] != "DU1" {
+		t.Errorf("expected the first credential to be owned by DU1, got %v", flattened[0])
+	}
+	if flattened[1]["user_id"] != "DU2" || flattened[1]["date_added"] != "2019-02-12T19:33:20Z" {
+		t.Errorf("expected the second credential to fall back to DU2, got %v", flattened[1])
+	}
+}
+
+func testAccCheckDataSourceWebAuthnCredentialsConfig() string {
+	return `
+data "duo_webauthn_credentials" "test" {}
+`
+}
@@ -0,0 +1,230 @@
+package duo
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	// driftLogMaxResults caps the number of administrator log entries
+	// retrieved from a single window while attributing drift.
+	driftLogMaxResults = 5000
+
+	// driftLogTTL is how long administrator log lookups are reused for,
+	// so that a refresh searches the log once rather than per resource.
+	driftLogTTL = 5 * time.Minute
+)
+
+// driftLogWindows are how far back the administrator log is searched for
+// the change responsible for drift. They're searched newest first, and an
+// older window is only read when the newer ones have no matching entry.
+var driftLogWindows = []time.Duration{
+	time.Hour,
+	24 * time.Hour,
+	7 * 24 * time.Hour,
+	30 * 24 * time.Hour,
+}
+
+// driftActionPrefixes are the kinds of administrator log entry that drift
+// is attributed to. Other entries are skipped before counting towards
+// driftLogMaxResults.
+var driftActionPrefixes = []string{"user_", "phone_", "integration_"}
+
+// driftLog holds the administrator log entries found in each of the
+// windows searched so far.
+type driftLog struct {
+	mu      sync.Mutex
+	created time.Time
+	windows map[int]*driftLogWindow
+}
+
+// driftLogWindow holds the latest administrator log entry for each kind of
+// action and object within one of driftLogWindows. ready is closed once the
+// window has been fetched.
+type driftLogWindow struct {
+	ready  chan struct{}
+	latest map[string]AdministratorLog
+	// truncated is set when the window held more entries than
+	// driftLogMaxResults, as it and older windows can't be trusted to hold
+	// the latest change for an object after that.
+	truncated bool
+	err       error
+}
+
+var (
+	driftLogsMu sync.Mutex
+	driftLogs   = map[*duoapi.DuoApi]*driftLog{}
+)
+
+// getDriftLog returns the administrator log lookup shared by the resources
+// refreshed with duoclient, dropping any lookups that have expired.
+func getDriftLog(duoclient *duoapi.DuoApi) *driftLog {
+	driftLogsMu.Lock()
+	defer driftLogsMu.Unlock()
+	for c, l := range driftLogs {
+		if time.Since(l.created) > driftLogTTL {
+			delete(driftLogs, c)
+		}
+	}
+	l, ok := driftLogs[duoclient]
+	if !ok {
+		l = &driftLog{created: time.Now().UTC(), windows: map[int]*driftLogWindow{}}
+		driftLogs[duoclient] = l
+	}
+	return l
+}
+
+func driftActionPrefix(action string) string {
+	for _, p := range driftActionPrefixes {
+		if strings.HasPrefix(action, p) {
+			return p
+		}
+	}
+	return ""
+}
+
+// window returns the i'th of driftLogWindows, fetching it if no other
+// lookup has. The fetch happens without holding l.mu, so lookups answered
+// by windows that have already been searched aren't held up by it.
+func (l *driftLog) window(duoAdminClient *admin.Client, i int) *driftLogWindow {
+	l.mu.Lock()
+	w, ok := l.windows[i]
+	if !ok {
+		w = &driftLogWindow{ready: make(chan struct{})}
+		l.windows[i] = w
+	}
+	l.mu.Unlock()
+
+	if ok {
+		<-w.ready
+		return w
+	}
+
+	maxtime := l.created
+	if i > 0 {
+		maxtime = l.created.Add(-driftLogWindows[i-1])
+	}
+	mintime := l.created.Add(-driftLogWindows[i])
+	logs, truncated, err := fetchAdministratorLogs(duoAdminClient, mintime, maxtime, driftLogMaxResults, func(e AdministratorLog) bool {
+		return driftActionPrefix(e.Action) != ""
+	})
+	if err != nil {
+		// Forget the failed window so that a later lookup retries it
+		l.mu.Lock()
+		delete(l.windows, i)
+		l.mu.Unlock()
+	}
+
+	w.latest = map[string]AdministratorLog{}
+	for _, e := range logs {
+		k := driftActionPrefix(e.Action) + e.Object
+		if latest, ok := w.latest[k]; !ok || e.Timestamp >= latest.Timestamp {
+			w.latest[k] = e
+		}
+	}
+	w.truncated, w.err = truncated, err
+	close(w.ready)
+	return w
+}
+
+// find returns the latest administrator log entry for one of objects with
+// an action starting with actionPrefix, searching older windows only until
+// one is found.
+func (l *driftLog) find(duoAdminClient *admin.Client, actionPrefix string, objects []string) (*AdministratorLog, error) {
+	for i := range driftLogWindows {
+		w := l.window(duoAdminClient, i)
+		if w.err != nil {
+			return nil, w.err
+		}
+		if w.truncated {
+			return nil, nil
+		}
+
+		var match *AdministratorLog
+		for _, o := range objects {
+			if e, ok := w.latest[actionPrefix+o]; ok && o != "" && (match == nil || e.Timestamp > match.Timestamp) {
+				match = &e
+			}
+		}
+		if match != nil {
+			return match, nil
+		}
+	}
+	return nil, nil
+}
+
+// driftAttributionSchema adds the arguments used to attribute drift to the
+// administrator responsible for it to a resource schema.
+func driftAttributionSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["attribute_drift"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	s["last_modified_by"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	s["last_modified_at"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	return s
+}
+
+// driftedAttributes returns the keys whose remote value differs from the
+// value currently held in state.
+func driftedAttributes(d *schema.ResourceData, remote map[string]string) []string {
+	var drifted []string
+	for k, v := range remote {
+		if d.Get(k).(string) != v {
+			drifted = append(drifted, k)
+		}
+	}
+	return drifted
+}
+
+// attributeDrift looks up which administrator last changed a drifted
+// object, matching administrator log entries by action prefix and object
+// name. Lookup failures are logged rather than returned, as attribution is
+// best effort and shouldn't prevent a refresh.
+func attributeDrift(d *schema.ResourceData, duoclient *duoapi.DuoApi, remote map[string]string, actionPrefix string, objects ...string) {
+	if !d.Get("attribute_drift").(bool) || d.IsNewResource() {
+		return
+	}
+	drifted := driftedAttributes(d, remote)
+	if len(drifted) == 0 {
+		return
+	}
+
+	match, err := getDriftLog(duoclient).find(admin.New(*duoclient), actionPrefix, objects)
+	if err != nil {
+		log.Printf("[WARN] could not attribute drift of %s %s: %s", d.Id(), strings.Join(drifted, ", "), err)
+	}
+	if match == nil {
+		if err == nil {
+			log.Printf("[WARN] %s drifted (%s) but no matching administrator log entry was found", d.Id(), strings.Join(drifted, ", "))
+		}
+		d.Set("last_modified_by", "")
+		d.Set("last_modified_at", "")
+		return
+	}
+
+	modifiedAt := secondsToTime(match.Timestamp)
+	log.Printf("[INFO] %s drifted (%s): last changed by %s at %s (%s)", d.Id(), strings.Join(drifted, ", "), match.Username, modifiedAt, match.Action)
+	d.Set("last_modified_by", match.Username)
+	d.Set("last_modified_at", modifiedAt)
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
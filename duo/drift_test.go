@@ -0,0 +1,182 @@
+package duo
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDriftedAttributes(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceUser().Schema, map[string]interface{}{
+		"username": "mister",
+		"email":    "le1f@wut.what",
+		"status":   "active",
+	})
+
+	drifted := driftedAttributes(d, map[string]string{
+		"username": "mister",
+		"email":    "someone@else.what",
+		"status":   "disabled",
+	})
+	sort.Strings(drifted)
+
+	expected := []string{"email", "status"}
+	if !reflect.DeepEqual(drifted, expected) {
+		t.Fatalf("expected %v, got %v", expected, drifted)
+	}
+}
+
+func TestDriftLogFind(t *testing.T) {
+	now := time.Now().UTC()
+	entries := []AdministratorLog{
+		AdministratorLog{Action: "phone_update", Object: "+18005551234", Username: "older", Timestamp: now.Add(-48 * time.Hour).Unix()},
+		AdministratorLog{Action: "phone_update", Object: "+18005551235", Username: "oldest", Timestamp: now.Add(-10 * 24 * time.Hour).Unix()},
+		AdministratorLog{Action: "admin_login", Object: "+18005551234", Username: "unrelated", Timestamp: now.Add(-20 * time.Minute).Unix()},
+		AdministratorLog{Action: "phone_update", Object: "+18005551234", Username: "newest", Timestamp: now.Add(-10 * time.Minute).Unix()},
+	}
+
+	requests := 0
+	client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		mintime, _ := strconv.ParseInt(r.URL.Query().Get("mintime"), 10, 64)
+		var page []AdministratorLog
+		for _, e := range entries {
+			if e.Timestamp >= mintime {
+				page = append(page, e)
+			}
+		}
+		sort.Slice(page, func(i, j int) bool { return page[i].Timestamp < page[j].Timestamp })
+		json.NewEncoder(w).Encode(map[string]interface{}{"stat": "OK", "response": page})
+	})
+	defer closeServer()
+
+	l := getDriftLog(client)
+	if getDriftLog(client) != l {
+		t.Fatal("expected the lookup to be reused")
+	}
+
+	match, err := l.find(admin.New(*client), "phone_", []string{"+18005551234"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if match == nil || match.Username != "newest" {
+		t.Fatalf("expected the newest change, got %+v", match)
+	}
+	if requests != 1 {
+		t.Errorf("expected only the newest window to be searched, got %d requests", requests)
+	}
+
+	match, err = l.find(admin.New(*client), "phone_", []string{"+18005551235"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if match == nil || match.Username != "oldest" {
+		t.Fatalf("expected the oldest change, got %+v", match)
+	}
+	if requests != len(driftLogWindows) {
+		t.Errorf("expected every window to be searched once, got %d requests", requests)
+	}
+
+	match, err = l.find(admin.New(*client), "phone_", []string{"+18005551236"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if match != nil {
+		t.Errorf("expected no match, got %+v", match)
+	}
+	if requests != len(driftLogWindows) {
+		t.Errorf("expected the searched windows to be reused, got %d requests", requests)
+	}
+}
+
+func TestGetDriftLog_prunesExpired(t *testing.T) {
+	expired := &duoapi.DuoApi{}
+	driftLogsMu.Lock()
+	driftLogs[expired] = &driftLog{created: time.Now().UTC().Add(-2 * driftLogTTL), windows: map[int]*driftLogWindow{}}
+	driftLogsMu.Unlock()
+
+	getDriftLog(&duoapi.DuoApi{})
+
+	driftLogsMu.Lock()
+	_, ok := driftLogs[expired]
+	driftLogsMu.Unlock()
+	if ok {
+		t.Error("expected the expired lookup to be pruned")
+	}
+}
+
+func TestDriftLogFind_concurrent(t *testing.T) {
+	now := time.Now().UTC()
+	recent := AdministratorLog{Action: "user_update", Object: "recent", Username: "newest", Timestamp: now.Add(-10 * time.Minute).Unix()}
+
+	release := make(chan struct{})
+	fetching := make(chan struct{})
+	var fetchingOnce sync.Once
+	client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mintime, _ := strconv.ParseInt(r.URL.Query().Get("mintime"), 10, 64)
+		if mintime < now.Add(-2*time.Hour).Unix() {
+			// Hold the older windows until the recent lookup has finished
+			fetchingOnce.Do(func() { close(fetching) })
+			<-release
+			json.NewEncoder(w).Encode(map[string]interface{}{"stat": "OK", "response": []AdministratorLog{}})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"stat": "OK", "response": []AdministratorLog{recent}})
+	})
+	defer closeServer()
+
+	l := &driftLog{created: now, windows: map[int]*driftLogWindow{}}
+	duoAdminClient := admin.New(*client)
+	if _, err := l.find(duoAdminClient, "user_", []string{"recent"}); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error)
+	go func() {
+		_, err := l.find(duoAdminClient, "user_", []string{"missing"})
+		done <- err
+	}()
+	<-fetching
+
+	match, err := l.find(duoAdminClient, "user_", []string{"recent"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if match == nil || match.Username != "newest" {
+		t.Errorf("expected the recent change while an older window is fetched, got %+v", match)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAttributeDrift_noMatch(t *testing.T) {
+	client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"stat": "OK", "response": []AdministratorLog{}})
+	})
+	defer closeServer()
+
+	d := schema.TestResourceDataRaw(t, resourcePhone().Schema, map[string]interface{}{
+		"name":            "mine",
+		"attribute_drift": true,
+	})
+	d.SetId("DPBBBBBBBBBBBBBBBBBB")
+	d.Set("last_modified_by", "someone")
+	d.Set("last_modified_at", "2019-02-12T19:33:20Z")
+
+	attributeDrift(d, client, map[string]string{"name": "theirs"}, "phone_", "theirs")
+	if d.Get("last_modified_by").(string) != "" || d.Get("last_modified_at").(string) != "" {
+		t.Errorf("expected the attribution to be cleared, got %s at %s", d.Get("last_modified_by"), d.Get("last_modified_at"))
+	}
+}
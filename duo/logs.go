@@ -0,0 +1,92 @@
+package duo
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// logWindowSchema returns the arguments shared by every log data source:
+// the time window to search and a cap on the number of records returned.
+func logWindowSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"mintime": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateRFC3339,
+		},
+		"maxtime": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateRFC3339,
+		},
+		"max_results": &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1000,
+			ValidateFunc: validatePositiveInt,
+		},
+		"truncated": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+// logWindow reads the time window from a log data source, defaulting
+// maxtime to the current time when it hasn't been configured.
+func logWindow(d *schema.ResourceData) (time.Time, time.Time, error) {
+	mintime, err := time.Parse(time.RFC3339, d.Get("mintime").(string))
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	maxtime := time.Now().UTC()
+	if v, ok := d.GetOk("maxtime"); ok {
+		maxtime, err = time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+	if !maxtime.After(mintime) {
+		return time.Time{}, time.Time{}, fmt.Errorf("maxtime %s must be after mintime %s", maxtime.Format(time.RFC3339), mintime.Format(time.RFC3339))
+	}
+	return mintime, maxtime, nil
+}
+
+// logPageLimit returns the page size to request so that a page never
+// fetches more records than are still wanted.
+func logPageLimit(remaining int) int {
+	if remaining < 1000 {
+		return remaining
+	}
+	return 1000
+}
+
+// logPageFetcher retrieves a single page of log records of at most limit
+// entries starting at offset, returning how many records it retrieved and
+// the offset of the next page, which is empty once the window is exhausted.
+type logPageFetcher func(limit int, offset string) (int, string, error)
+
+// paginateLogs follows a log API's cursor until the window is exhausted or
+// maxResults records have been retrieved. It reports whether records were
+// left unread because of the cap.
+func paginateLogs(maxResults int, fetch logPageFetcher) (bool, error) {
+	total := 0
+	offset := ""
+	for {
+		n, next, err := fetch(logPageLimit(maxResults-total), offset)
+		if err != nil {
+			return false, err
+		}
+		total += n
+		if n == 0 || next == "" {
+			return false, nil
+		}
+		if total >= maxResults {
+			return true, nil
+		}
+		offset = next
+	}
+}
@@ -31,13 +31,36 @@ func Provider() *schema.Provider {
 			},
 		},
 		ConfigureFunc: providerConfigure,
+		DataSourcesMap: map[string]*schema.Resource{
+			"duo_account_summary":           dataSourceAccountSummary(),
+			"duo_administrator_logs":        dataSourceAdministratorLogs(),
+			"duo_authentication_logs":       dataSourceAuthenticationLogs(),
+			"duo_telephony_credits":         dataSourceTelephonyCredits(),
+			"duo_telephony_logs":            dataSourceTelephonyLogs(),
+			"duo_u2f_tokens":                dataSourceU2FTokens(),
+			"duo_user_webauthn_credentials": dataSourceUserWebAuthnCredentials(),
+			"duo_webauthn_credentials":      dataSourceWebAuthnCredentials(),
+		},
 		ResourcesMap: map[string]*schema.Resource{
-			"duo_admin":                  resourceAdmin(),
-			"duo_admin_auth_factors":     resourceAdminAuthFactors(),
-			"duo_integration":            resourceIntegration(),
-			"duo_user":                   resourceUser(),
-			"duo_phone":                  resourcePhone(),
-			"duo_user_phone_association": resourceUserPhoneAssociation(),
+			"duo_admin":                           resourceAdmin(),
+			"duo_admin_auth_factors":              resourceAdminAuthFactors(),
+			"duo_admin_role":                      resourceAdminRole(),
+			"duo_admin_unlock":                    resourceAdminUnlock(),
+			"duo_administrative_unit":             resourceAdministrativeUnit(),
+			"duo_administrative_unit_admin":       resourceAdministrativeUnitAdmin(),
+			"duo_administrative_unit_group":       resourceAdministrativeUnitGroup(),
+			"duo_administrative_unit_integration": resourceAdministrativeUnitIntegration(),
+			"duo_group_members":                   resourceGroupMembers(),
+			"duo_hardware_token_resync":           resourceHardwareTokenResync(),
+			"duo_integration":                     resourceIntegration(),
+			"duo_u2f_token_revocation":            resourceU2FTokenRevocation(),
+			"duo_user":                            resourceUser(),
+			"duo_user_enrollment":                 resourceUserEnrollment(),
+			"duo_phone":                           resourcePhone(),
+			"duo_phone_sms_passcodes":             resourcePhoneSMSPasscodes(),
+			"duo_user_phones":                     resourceUserPhones(),
+			"duo_user_phone_association":          resourceUserPhoneAssociation(),
+			"duo_webauthn_credential_revocation":  resourceWebAuthnCredentialRevocation(),
 		},
 	}
 }
@@ -1,9 +1,13 @@
 package duo
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"testing"
 
+	"github.com/duosecurity/duo_api_golang"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -48,3 +52,13 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+// testFakeDuoClient returns a client that talks to handler instead of Duo.
+func testFakeDuoClient(t *testing.T, handler http.HandlerFunc) (*duoapi.DuoApi, func()) {
+	server := httptest.NewTLSServer(handler)
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return duoapi.NewDuoApi("ikey", "skey", u.Host, "terraform-provider-duo-test", duoapi.SetInsecure()), server.Close
+}
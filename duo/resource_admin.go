@@ -8,6 +8,9 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/duosecurity/duo_api_golang"
 	admin "github.com/duosecurity/duo_api_golang/admin"
@@ -25,6 +28,8 @@ func resourceAdmin() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAdminCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"email": &schema.Schema{
 				Type:     schema.TypeString,
@@ -39,23 +44,117 @@ func resourceAdmin() *schema.Resource {
 				Required: true,
 			},
 			"password": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"onboarding"},
+			},
+			"rotation_trigger": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"password_reset_trigger": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 			},
 			"role": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"custom_role_id"},
+			},
+			"custom_role_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"role"},
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateStringInSlice([]string{"Active", "Disabled"}),
+			},
+			"restricted_by_admin_units": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"admin_units": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"admin_id": &schema.Schema{
 				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_login": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"password_change_required": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"has_external_password_mgmt": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"onboarding": &schema.Schema{
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ForceNew:      true,
+				ConflictsWith: []string{"password"},
+			},
+			"valid_days": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				ValidateFunc: validateActivationValidDays,
+			},
+			"send_email": &schema.Schema{
+				Type:     schema.TypeBool,
 				Optional: true,
+				Default:  true,
+			},
+			"activation_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"activation_link": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"activation_expires": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 		},
 	}
 }
 
+func validateActivationValidDays(v interface{}, k string) (ws []string, errors []error) {
+	if days := v.(int); days < 1 || days > 31 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 31 days, got %d", k, days))
+	}
+	return
+}
+
 type Admin struct {
-	AdminID string `json:"admin_id"`
-	Email   string `json:"email"`
-	Name    string `json:"name"`
-	Phone   string `json:"phone"`
-	Role    string `json:"role"`
+	AdminID                string   `json:"admin_id"`
+	Email                  string   `json:"email"`
+	Name                   string   `json:"name"`
+	Phone                  string   `json:"phone"`
+	Role                   string   `json:"role"`
+	Status                 string   `json:"status"`
+	RestrictedByAdminUnits bool     `json:"restricted_by_admin_units"`
+	AdminUnits             []string `json:"admin_units"`
+	LastLogin              *int64   `json:"last_login"`
+	PasswordChangeRequired bool     `json:"password_change_required"`
 }
 
 type AdminResult struct {
@@ -63,7 +162,218 @@ type AdminResult struct {
 	Response Admin
 }
 
+type AdminPasswordMgmt struct {
+	AdminID                 string `json:"admin_id"`
+	HasExternalPasswordMgmt bool   `json:"has_external_password_mgmt"`
+}
+
+type AdminPasswordMgmtResult struct {
+	duoapi.StatResult
+	Response AdminPasswordMgmt
+}
+
+type AdminsResult struct {
+	duoapi.StatResult
+	admin.ListResult
+	Response []Admin
+}
+
+type AdminActivation struct {
+	AdminActivationID string `json:"admin_activation_id"`
+	Email             string `json:"email"`
+	Expires           int64  `json:"expires"`
+	Link              string `json:"link"`
+	Role              string `json:"role"`
+}
+
+type AdminActivationResult struct {
+	duoapi.StatResult
+	Response AdminActivation
+}
+
+type AdminActivationsResult struct {
+	duoapi.StatResult
+	admin.ListResult
+	Response []AdminActivation
+}
+
+// resourceAdminCustomizeDiff rejects configurations that set a Duo
+// password for an admin whose password is managed externally, and password
+// rotations without a password to rotate to.
+func resourceAdminCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("rotation_trigger").(string) != "" && d.NewValueKnown("password") && d.Get("password").(string) == "" {
+		return fmt.Errorf("rotation_trigger requires password to be set")
+	}
+	if !d.Get("has_external_password_mgmt").(bool) {
+		return nil
+	}
+	if d.Get("password").(string) != "" {
+		return fmt.Errorf("password can't be set when has_external_password_mgmt is enabled")
+	}
+	if d.Get("onboarding").(bool) {
+		return fmt.Errorf("onboarding can't be used when has_external_password_mgmt is enabled")
+	}
+	return nil
+}
+
+// setAdminPasswordMgmt enables or disables external password management
+// for an admin. Disabling it requires a password to be set for the admin.
+func setAdminPasswordMgmt(duoAdminClient *admin.Client, adminID string, external bool, password string) error {
+	params := url.Values{}
+	params.Set("has_external_password_mgmt", boolParser(external))
+	if !external {
+		if password == "" {
+			return fmt.Errorf("a password is required to disable external password management for admin %s", adminID)
+		}
+		params.Set("password", password)
+	}
+
+	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/admins/%s/password_mgmt", adminID), params, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+	result := &AdminPasswordMgmtResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("there was a problem updating password management of admin %s: %s", adminID, *result.Message)
+	}
+	return nil
+}
+
+// adminRole returns the name of the role to assign to an admin, looking
+// up the custom role when the admin references one by ID.
+func adminRole(d *schema.ResourceData, duoAdminClient *admin.Client) (string, error) {
+	roleID := d.Get("custom_role_id").(string)
+	if roleID == "" {
+		return d.Get("role").(string), nil
+	}
+
+	result, err := getAdminRole(duoAdminClient, roleID)
+	if err != nil {
+		return "", err
+	}
+	if result.Stat != "OK" {
+		return "", fmt.Errorf("could not find admin role %s %s: %s", roleID, result.Stat, *result.Message)
+	}
+	return result.Response.Name, nil
+}
+
+// readAdminRole records the role an admin holds. A role that matches a
+// custom role is stored as custom_role_id, otherwise it's stored as role.
+func readAdminRole(d *schema.ResourceData, duoAdminClient *admin.Client, role string) error {
+	if roleID := d.Get("custom_role_id").(string); roleID != "" {
+		result, err := getAdminRole(duoAdminClient, roleID)
+		if err != nil {
+			return err
+		}
+		if result.Stat != "OK" && *result.Message != "Resource not found" {
+			return fmt.Errorf("could not find admin role %s %s: %s", roleID, result.Stat, *result.Message)
+		}
+		if result.Stat == "OK" && result.Response.Name == role {
+			d.Set("role", "")
+			return nil
+		}
+	}
+
+	if role != "" {
+		customRole, err := findAdminRoleByName(duoAdminClient, role)
+		if err != nil {
+			return err
+		}
+		if customRole != nil {
+			d.Set("custom_role_id", customRole.RoleID)
+			d.Set("role", "")
+			return nil
+		}
+	}
+
+	d.Set("custom_role_id", "")
+	d.Set("role", role)
+	return nil
+}
+
+// adminPending reports whether the resource is still tracking an
+// activation that hasn't been consumed yet.
+func adminPending(d *schema.ResourceData) bool {
+	activationID := d.Get("activation_id").(string)
+	return activationID != "" && activationID == d.Id()
+}
+
+// findAdminActivation pages through the pending admin activations looking
+// for activationID.
+func findAdminActivation(duoAdminClient *admin.Client, activationID string) (*AdminActivation, error) {
+	params := url.Values{}
+	params.Set("limit", "100")
+	params.Set("offset", "0")
+	for {
+		_, body, err := duoAdminClient.SignedCall("GET", "/admin/v1/admins/activations", params, duoapi.UseTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		result := &AdminActivationsResult{}
+		err = json.Unmarshal(body, result)
+		if err != nil {
+			return nil, err
+		}
+		if result.Stat != "OK" {
+			return nil, fmt.Errorf("could not list admin activations %s: %s", result.Stat, *result.Message)
+		}
+		for _, a := range result.Response {
+			if a.AdminActivationID == activationID {
+				return &a, nil
+			}
+		}
+
+		nextOffset := result.Metadata.NextOffset.String()
+		if nextOffset == "" {
+			return nil, nil
+		}
+		params.Set("offset", nextOffset)
+	}
+}
+
+// findAdminByEmail pages through the admins looking for one with email.
+func findAdminByEmail(duoAdminClient *admin.Client, email string) (*Admin, error) {
+	params := url.Values{}
+	params.Set("limit", "100")
+	params.Set("offset", "0")
+	for {
+		_, body, err := duoAdminClient.SignedCall("GET", "/admin/v1/admins", params, duoapi.UseTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		result := &AdminsResult{}
+		err = json.Unmarshal(body, result)
+		if err != nil {
+			return nil, err
+		}
+		if result.Stat != "OK" {
+			return nil, fmt.Errorf("could not list admins %s: %s", result.Stat, *result.Message)
+		}
+		for _, a := range result.Response {
+			if strings.EqualFold(a.Email, email) {
+				return &a, nil
+			}
+		}
+
+		nextOffset := result.Metadata.NextOffset.String()
+		if nextOffset == "" {
+			return nil, nil
+		}
+		params.Set("offset", nextOffset)
+	}
+}
+
 func resourceAdminCreate(d *schema.ResourceData, meta interface{}) error {
+	if d.Get("onboarding").(bool) {
+		return resourceAdminCreateActivation(d, meta)
+	}
+
 	duoclient := meta.(*duoapi.DuoApi)
 	duoAdminClient := admin.New(*duoclient)
 
@@ -78,15 +388,22 @@ func resourceAdminCreate(d *schema.ResourceData, meta interface{}) error {
 		h := sha1.New()
 		h.Write(b)
 		rnd := hex.EncodeToString(h.Sum(nil))
-		log.Print("[DEBUG] User's temporary password is", rnd)
 		params.Set("password", rnd)
 	} else {
 		params.Set("password", d.Get("password").(string))
 	}
 	params.Set("name", d.Get("name").(string))
 	params.Set("phone", d.Get("phone").(string))
-	if d.Get("role").(string) != "" {
-		params.Set("role", d.Get("role").(string))
+	role, err := adminRole(d, duoAdminClient)
+	if err != nil {
+		return err
+	}
+	if role != "" {
+		params.Set("role", role)
+	}
+	params.Set("restricted_by_admin_units", boolParser(d.Get("restricted_by_admin_units")))
+	if v, ok := d.GetOkExists("password_change_required"); ok {
+		params.Set("password_change_required", boolParser(v))
 	}
 
 	_, body, err := duoAdminClient.SignedCall("POST", "/admin/v1/admins", params, duoapi.UseTimeout)
@@ -104,13 +421,125 @@ func resourceAdminCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	adminID := result.Response.AdminID
 	d.SetId(adminID)
+
+	// Admins are always created active and outside of any administrative
+	// unit, anything else is applied once the admin exists
+	if d.Get("status").(string) == "Disabled" {
+		params := url.Values{}
+		params.Set("status", "Disabled")
+		_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/admins/%s", adminID), params, duoapi.UseTimeout)
+		if err != nil {
+			return err
+		}
+		result := &AdminResult{}
+		err = json.Unmarshal(body, result)
+		if err != nil {
+			return err
+		}
+		if result.Stat != "OK" {
+			return fmt.Errorf("there was a problem disabling admin %s: %s", adminID, *result.Message)
+		}
+	}
+	if d.Get("has_external_password_mgmt").(bool) {
+		if err := setAdminPasswordMgmt(duoAdminClient, adminID, true, ""); err != nil {
+			return err
+		}
+	}
+	if units, ok := d.GetOk("admin_units"); ok {
+		if err := updateAdminUnits(duoAdminClient, adminID, &schema.Set{F: schema.HashString}, units.(*schema.Set)); err != nil {
+			return err
+		}
+	}
 	return resourceAdminRead(d, meta)
 }
 
+// resourceAdminCreateActivation creates a pending admin which is emailed
+// an activation link, rather than an admin with a password nobody knows.
+// The resource tracks the activation until it has been consumed.
+func resourceAdminCreateActivation(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	params := url.Values{}
+	params.Set("email", d.Get("email").(string))
+	params.Set("valid_days", strconv.Itoa(d.Get("valid_days").(int)))
+	if d.Get("send_email").(bool) {
+		params.Set("send_email", "1")
+	} else {
+		params.Set("send_email", "0")
+	}
+	role, err := adminRole(d, duoAdminClient)
+	if err != nil {
+		return err
+	}
+	if role != "" {
+		params.Set("admin_role", role)
+	}
+
+	_, body, err := duoAdminClient.SignedCall("POST", "/admin/v1/admins/activations", params, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+
+	result := &AdminActivationResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("could not create admin activation for %s %s: %s", d.Get("email").(string), result.Stat, *result.Message)
+	}
+
+	d.SetId(result.Response.AdminActivationID)
+	d.Set("activation_id", result.Response.AdminActivationID)
+	d.Set("activation_link", result.Response.Link)
+	d.Set("activation_expires", secondsToTime(result.Response.Expires))
+	return resourceAdminRead(d, meta)
+}
+
+// resourceAdminReadActivation refreshes a pending admin. Once the
+// activation has been consumed the resource switches over to tracking the
+// admin it created; if it expired or was deleted the resource is removed
+// from state so a new activation is created.
+func resourceAdminReadActivation(d *schema.ResourceData, duoAdminClient *admin.Client) (bool, error) {
+	activation, err := findAdminActivation(duoAdminClient, d.Id())
+	if err != nil {
+		return false, err
+	}
+	if activation != nil {
+		d.Set("email", activation.Email)
+		d.Set("activation_link", activation.Link)
+		d.Set("activation_expires", secondsToTime(activation.Expires))
+		return true, nil
+	}
+
+	activated, err := findAdminByEmail(duoAdminClient, d.Get("email").(string))
+	if err != nil {
+		return false, err
+	}
+	if activated == nil {
+		log.Printf("[WARN] admin activation %s for %s is gone and no admin was created, removing from state", d.Id(), d.Get("email").(string))
+		d.SetId("")
+		return true, nil
+	}
+
+	log.Printf("[INFO] admin activation %s was consumed, now tracking admin %s", d.Id(), activated.AdminID)
+	d.SetId(activated.AdminID)
+	d.Set("activation_link", "")
+	return false, nil
+}
+
 func resourceAdminRead(d *schema.ResourceData, meta interface{}) error {
 	duoclient := meta.(*duoapi.DuoApi)
 	duoAdminClient := admin.New(*duoclient)
 
+	if adminPending(d) {
+		done, err := resourceAdminReadActivation(d, duoAdminClient)
+		if err != nil || done {
+			return err
+		}
+	}
+
 	_, body, err := duoAdminClient.SignedCall("GET", fmt.Sprintf("/admin/v1/admins/%s", d.Id()), nil, duoapi.UseTimeout)
 	if err != nil {
 		return err
@@ -132,7 +561,32 @@ func resourceAdminRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("email", result.Response.Email)
 	d.Set("name", result.Response.Name)
 	d.Set("phone", result.Response.Phone)
-	d.Set("role", result.Response.Role)
+	if err := readAdminRole(d, duoAdminClient, result.Response.Role); err != nil {
+		return err
+	}
+	d.Set("status", result.Response.Status)
+	d.Set("restricted_by_admin_units", result.Response.RestrictedByAdminUnits)
+	d.Set("admin_units", result.Response.AdminUnits)
+	d.Set("admin_id", result.Response.AdminID)
+	if result.Response.LastLogin != nil {
+		d.Set("last_login", secondsToTime(*result.Response.LastLogin))
+	} else {
+		d.Set("last_login", "")
+	}
+
+	_, body, err = duoAdminClient.SignedCall("GET", fmt.Sprintf("/admin/v1/admins/%s/password_mgmt", d.Id()), nil, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+	passwordMgmt := &AdminPasswordMgmtResult{}
+	err = json.Unmarshal(body, passwordMgmt)
+	if err != nil {
+		return err
+	}
+	if passwordMgmt.Stat != "OK" {
+		return fmt.Errorf("could not read password management of admin %s %s: %s", d.Id(), passwordMgmt.Stat, *passwordMgmt.Message)
+	}
+	d.Set("has_external_password_mgmt", passwordMgmt.Response.HasExternalPasswordMgmt)
 	return nil
 }
 
@@ -140,12 +594,75 @@ func resourceAdminUpdate(d *schema.ResourceData, meta interface{}) error {
 	duoclient := meta.(*duoapi.DuoApi)
 	duoAdminClient := admin.New(*duoclient)
 
+	// A pending admin can't be modified until the activation has been
+	// consumed, so refuse rather than drop the planned changes
+	if adminPending(d) {
+		var changed []string
+		for k := range resourceAdmin().Schema {
+			if d.HasChange(k) {
+				changed = append(changed, k)
+			}
+		}
+		sort.Strings(changed)
+		return fmt.Errorf("admin %s is still pending activation, %s can't be changed until the activation link has been used", d.Get("email").(string), strings.Join(changed, ", "))
+	}
+
+	adminID := d.Id()
+	params := url.Values{}
+
 	d.Partial(true)
 
-	if d.HasChange("name") && !d.IsNewResource() {
-		params := url.Values{}
-		params.Set("name", d.Get("name").(string))
-		_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/admins/%s", d.Id()), params, duoapi.UseTimeout)
+	// Password management is switched first, as an externally managed
+	// admin can't have its password changed
+	if d.HasChange("has_external_password_mgmt") {
+		if err := setAdminPasswordMgmt(duoAdminClient, adminID, d.Get("has_external_password_mgmt").(bool), d.Get("password").(string)); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range []string{"email", "name", "phone", "status"} {
+		if d.HasChange(k) {
+			params.Set(k, d.Get(k).(string))
+		}
+	}
+	if d.HasChange("role") || d.HasChange("custom_role_id") {
+		role, err := adminRole(d, duoAdminClient)
+		if err != nil {
+			return err
+		}
+		if role != "" {
+			params.Set("role", role)
+		}
+	}
+	if d.HasChange("restricted_by_admin_units") {
+		params.Set("restricted_by_admin_units", boolParser(d.Get("restricted_by_admin_units")))
+	}
+	// Duo clears password_change_required once the admin has picked a new
+	// password, so it's only ever sent and isn't read back
+	if d.HasChange("password_change_required") {
+		params.Set("password_change_required", boolParser(d.Get("password_change_required")))
+	}
+
+	// Changing the reset trigger forces the admin to choose a new password
+	// at their next login, e.g. after a credential leak
+	if d.HasChange("password_reset_trigger") && d.Get("password_reset_trigger").(string) != "" {
+		params.Set("password_change_required", "true")
+	}
+
+	// Passwords can't be read back, so a rotation is requested either by
+	// changing the password or by changing the rotation trigger to re-send it
+	if d.HasChange("password") || d.HasChange("rotation_trigger") {
+		password := d.Get("password").(string)
+		if password == "" && d.HasChange("rotation_trigger") {
+			return fmt.Errorf("rotation_trigger requires password to be set for admin %s", adminID)
+		}
+		if password != "" {
+			params.Set("password", password)
+		}
+	}
+
+	if len(params) > 0 {
+		_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/admins/%s", adminID), params, duoapi.UseTimeout)
 		if err != nil {
 			return err
 		}
@@ -159,46 +676,54 @@ func resourceAdminUpdate(d *schema.ResourceData, meta interface{}) error {
 				d.SetId("")
 				return nil
 			}
-			return fmt.Errorf("there was a problem updating user %s's name: %s", d.Id(), *result.Message)
+			return fmt.Errorf("there was a problem updating admin %s: %s", adminID, *result.Message)
 		}
-		d.SetPartial("name")
 	}
-	if d.HasChange("phone") && !d.IsNewResource() {
-		params := url.Values{}
-		params.Set("phone", d.Get("phone").(string))
-		_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/admins/%s", d.Id()), params, duoapi.UseTimeout)
+
+	if d.HasChange("admin_units") {
+		o, n := d.GetChange("admin_units")
+		if err := updateAdminUnits(duoAdminClient, adminID, o.(*schema.Set), n.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+	d.Partial(false)
+	return resourceAdminRead(d, meta)
+}
+
+// updateAdminUnits assigns an admin to the administrative units added to
+// the set and removes it from those no longer listed.
+func updateAdminUnits(duoAdminClient *admin.Client, adminID string, o, n *schema.Set) error {
+	for _, unitID := range o.Difference(n).List() {
+		path := fmt.Sprintf("/admin/v1/administrative_units/%s/admin/%s", unitID.(string), adminID)
+		_, body, err := duoAdminClient.SignedCall("DELETE", path, nil, duoapi.UseTimeout)
 		if err != nil {
 			return err
 		}
-		result := &AdminResult{}
-		err = json.Unmarshal(body, result)
+		var result deleteResult
+		err = json.Unmarshal(body, &result)
 		if err != nil {
 			return err
 		}
 		if result.Stat != "OK" {
-			return fmt.Errorf("there was a problem updating user %s's phone: %s", d.Id(), *result.Message)
+			return fmt.Errorf("there was a problem removing admin %s from administrative unit %s: %s", adminID, unitID.(string), *result.Message)
 		}
-		d.SetPartial("phone")
 	}
-	if d.HasChange("role") && !d.IsNewResource() {
-		params := url.Values{}
-		params.Set("role", d.Get("role").(string))
-		_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/admins/%s", d.Id()), params, duoapi.UseTimeout)
+	for _, unitID := range n.Difference(o).List() {
+		path := fmt.Sprintf("/admin/v1/administrative_units/%s/admin/%s", unitID.(string), adminID)
+		_, body, err := duoAdminClient.SignedCall("POST", path, nil, duoapi.UseTimeout)
 		if err != nil {
 			return err
 		}
-		result := &AdminResult{}
+		result := &AdministrativeUnitResult{}
 		err = json.Unmarshal(body, result)
 		if err != nil {
 			return err
 		}
 		if result.Stat != "OK" {
-			return fmt.Errorf("there was a problem updating user %s's role: %s", d.Id(), *result.Message)
+			return fmt.Errorf("could not add admin %s to administrative unit %s: %s", adminID, unitID.(string), *result.Message)
 		}
-		d.SetPartial("role")
 	}
-	d.Partial(false)
-	return resourceAdminRead(d, meta)
+	return nil
 }
 
 func resourceAdminDelete(d *schema.ResourceData, meta interface{}) error {
@@ -206,7 +731,11 @@ func resourceAdminDelete(d *schema.ResourceData, meta interface{}) error {
 	duoAdminClient := admin.New(*duoclient)
 
 	adminID := d.Id()
-	_, body, err := duoAdminClient.SignedCall("DELETE", fmt.Sprintf("/admin/v1/admins/%s", adminID), nil, duoapi.UseTimeout)
+	path := fmt.Sprintf("/admin/v1/admins/%s", adminID)
+	if adminPending(d) {
+		path = fmt.Sprintf("/admin/v1/admins/activations/%s", adminID)
+	}
+	_, body, err := duoAdminClient.SignedCall("DELETE", path, nil, duoapi.UseTimeout)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,232 @@
+package duo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// adminRolePermissions lists the permissions that can be granted to a
+// custom admin role.
+var adminRolePermissions = []string{
+	"manage_admins",
+	"manage_administrative_units",
+	"manage_billing",
+	"manage_bypass_codes",
+	"manage_groups",
+	"manage_integrations",
+	"manage_phones",
+	"manage_policies",
+	"manage_settings",
+	"manage_tokens",
+	"manage_users",
+	"read_admins",
+	"read_integrations",
+	"read_logs",
+	"read_policies",
+	"read_reports",
+	"read_settings",
+	"read_users",
+	"send_enrollment",
+}
+
+func resourceAdminRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAdminRoleCreate,
+		Read:   resourceAdminRoleRead,
+		Update: resourceAdminRoleUpdate,
+		Delete: resourceAdminRoleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"permissions": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateStringInSlice(adminRolePermissions),
+				},
+				Set: schema.HashString,
+			},
+			"role_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type AdminRole struct {
+	RoleID      string   `json:"role_id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+type AdminRoleResult struct {
+	duoapi.StatResult
+	Response AdminRole
+}
+
+type AdminRolesResult struct {
+	duoapi.StatResult
+	admin.ListResult
+	Response []AdminRole
+}
+
+func getAdminRole(duoAdminClient *admin.Client, roleID string) (*AdminRoleResult, error) {
+	_, body, err := duoAdminClient.SignedCall("GET", fmt.Sprintf("/admin/v1/admins/custom_roles/%s", roleID), nil, duoapi.UseTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AdminRoleResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// findAdminRoleByName pages through the custom admin roles looking for one
+// called name.
+func findAdminRoleByName(duoAdminClient *admin.Client, name string) (*AdminRole, error) {
+	params := url.Values{}
+	params.Set("limit", "100")
+	params.Set("offset", "0")
+	for {
+		_, body, err := duoAdminClient.SignedCall("GET", "/admin/v1/admins/custom_roles", params, duoapi.UseTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		result := &AdminRolesResult{}
+		err = json.Unmarshal(body, result)
+		if err != nil {
+			return nil, err
+		}
+		if result.Stat != "OK" {
+			return nil, fmt.Errorf("could not list admin roles %s: %s", result.Stat, *result.Message)
+		}
+		for _, r := range result.Response {
+			if r.Name == name {
+				return &r, nil
+			}
+		}
+
+		nextOffset := result.Metadata.NextOffset.String()
+		if nextOffset == "" {
+			return nil, nil
+		}
+		params.Set("offset", nextOffset)
+	}
+}
+
+func adminRoleParams(d *schema.ResourceData) url.Values {
+	params := url.Values{}
+	params.Set("name", d.Get("name").(string))
+	params.Set("description", d.Get("description").(string))
+	for _, p := range d.Get("permissions").(*schema.Set).List() {
+		params.Add("permissions", p.(string))
+	}
+	return params
+}
+
+func resourceAdminRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	_, body, err := duoAdminClient.SignedCall("POST", "/admin/v1/admins/custom_roles", adminRoleParams(d), duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+
+	result := &AdminRoleResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("could not create admin role %s %s", result.Stat, *result.Message)
+	}
+	d.SetId(result.Response.RoleID)
+	return resourceAdminRoleRead(d, meta)
+}
+
+func resourceAdminRoleRead(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	result, err := getAdminRole(duoAdminClient, d.Id())
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		if *result.Message == "Resource not found" {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("could not read admin role from duo %s, %s", result.Stat, *result.Message)
+	}
+
+	d.Set("name", result.Response.Name)
+	d.Set("description", result.Response.Description)
+	d.Set("permissions", result.Response.Permissions)
+	d.Set("role_id", result.Response.RoleID)
+	return nil
+}
+
+func resourceAdminRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	roleID := d.Id()
+	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/admins/custom_roles/%s", roleID), adminRoleParams(d), duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+	result := &AdminRoleResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("there was a problem updating admin role %s: %s", roleID, *result.Message)
+	}
+	return resourceAdminRoleRead(d, meta)
+}
+
+func resourceAdminRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	roleID := d.Id()
+	_, body, err := duoAdminClient.SignedCall("DELETE", fmt.Sprintf("/admin/v1/admins/custom_roles/%s", roleID), nil, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+	var result deleteResult
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("there was a problem deleting admin role %s: %s", roleID, *result.Message)
+	}
+	return nil
+}
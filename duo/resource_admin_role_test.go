@@ -0,0 +1,143 @@
+package duo
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAdminRole_Basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAdminRoleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckAdminRoleConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdminRoleExists("duo_admin_role.test"),
+					resource.TestCheckResourceAttr(
+						"duo_admin_role.test", "name", fmt.Sprintf("test-role-%d", rInt)),
+					resource.TestCheckResourceAttr(
+						"duo_admin_role.test", "permissions.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"duo_admin.test", "custom_role_id", "duo_admin_role.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"duo_admin.test", "role", "duo_admin_role.test", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAdminRole_invalidPermission(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccCheckAdminRoleConfigInvalid(),
+				ExpectError: regexp.MustCompile("must be one of"),
+			},
+		},
+	})
+}
+
+func TestAccAdminRole_import(t *testing.T) {
+	resourceName := "duo_admin_role.test"
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAdminRoleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckAdminRoleConfig(rInt),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAdminRoleDestroy(s *terraform.State) error {
+	duoclient := testAccProvider.Meta().(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "duo_admin_role" {
+			continue
+		}
+
+		result, err := getAdminRole(duoAdminClient, r.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if result.Stat == "OK" {
+			return fmt.Errorf("Found admin role when it should have been deleted: %+v", result.Response)
+		}
+	}
+	return nil
+}
+
+func testAccCheckAdminRoleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+		duoclient := testAccProvider.Meta().(*duoapi.DuoApi)
+		duoAdminClient := admin.New(*duoclient)
+
+		result, err := getAdminRole(duoAdminClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if result.Stat != "OK" {
+			return fmt.Errorf("Could not find admin role %s %s", result.Stat, *result.Message)
+		}
+
+		if result.Response.RoleID != rs.Primary.ID {
+			return fmt.Errorf("Admin role not found: %v - %v", rs.Primary.ID, result.Response.RoleID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckAdminRoleConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "duo_admin_role" "test" {
+  name = "test-role-%d"
+  description = "helpdesk"
+  permissions = ["read_users", "send_enrollment"]
+}
+
+resource "duo_admin" "test" {
+  name = "test-%d"
+  email = "le1f@wut.wut"
+  phone = "+12813308004"
+  custom_role_id = "${duo_admin_role.test.id}"
+}
+`, rInt, rInt)
+}
+
+func testAccCheckAdminRoleConfigInvalid() string {
+	return `
+resource "duo_admin_role" "test" {
+  name = "test-role"
+  permissions = ["do_anything"]
+}
+`
+}
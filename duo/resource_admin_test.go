@@ -3,12 +3,16 @@ package duo
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/duosecurity/duo_api_golang"
 	admin "github.com/duosecurity/duo_api_golang/admin"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -47,6 +51,213 @@ func TestAccAdmin_Basic(t *testing.T) {
 	})
 }
 
+func TestAccAdmin_disable(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAdminDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckAdminConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdminExists("duo_admin.test"),
+					resource.TestCheckResourceAttr(
+						"duo_admin.test", "status", "Active"),
+					resource.TestCheckResourceAttrSet(
+						"duo_admin.test", "admin_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckAdminConfigDisabled(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdminExists("duo_admin.test"),
+					resource.TestCheckResourceAttr(
+						"duo_admin.test", "status", "Disabled"),
+					resource.TestCheckResourceAttr(
+						"duo_admin.test", "email", "le1f-disabled@wut.wut"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAdmin_passwordRotation(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAdminDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckAdminConfigPassword(rInt, "1", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdminExists("duo_admin.test"),
+					resource.TestCheckResourceAttr(
+						"duo_admin.test", "rotation_trigger", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckAdminConfigPassword(rInt, "2", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdminExists("duo_admin.test"),
+					resource.TestCheckResourceAttr(
+						"duo_admin.test", "rotation_trigger", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckAdminConfigPassword(rInt, "2", "incident-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdminExists("duo_admin.test"),
+					resource.TestCheckResourceAttr(
+						"duo_admin.test", "password_reset_trigger", "incident-1"),
+				),
+			},
+			resource.TestStep{
+				Config:      testAccCheckAdminConfigRotationWithoutPassword(rInt),
+				ExpectError: regexp.MustCompile("rotation_trigger requires password"),
+			},
+		},
+	})
+}
+
+func TestAccAdmin_externalPasswordMgmt(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAdminDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckAdminConfigExternalPasswordMgmt(rInt, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdminExists("duo_admin.test"),
+					resource.TestCheckResourceAttr(
+						"duo_admin.test", "has_external_password_mgmt", "true"),
+				),
+			},
+			resource.TestStep{
+				Config:      testAccCheckAdminConfigExternalPasswordMgmt(rInt, "Correct-Horse-Battery-Staple"),
+				ExpectError: regexp.MustCompile("password can't be set when has_external_password_mgmt is enabled"),
+			},
+		},
+	})
+}
+
+func TestAccAdmin_onboarding(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAdminDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckAdminConfigOnboarding(acctest.RandInt()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"duo_admin.test", "id", "duo_admin.test", "activation_id"),
+					resource.TestCheckResourceAttrSet(
+						"duo_admin.test", "activation_link"),
+					resource.TestCheckResourceAttrSet(
+						"duo_admin.test", "activation_expires"),
+					resource.TestCheckResourceAttr(
+						"duo_admin.test", "email", "le1f@wut.wut"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAdminUpdate_pending(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAdmin().Schema, map[string]interface{}{
+		"email":      "le1f@wut.what",
+		"name":       "Mister Sir",
+		"phone":      "+18005551234",
+		"onboarding": true,
+	})
+	d.SetId("DAAAAAAAAAAAAAAAAAAA")
+	d.Set("activation_id", "DAAAAAAAAAAAAAAAAAAA")
+
+	err := resourceAdminUpdate(d, &duoapi.DuoApi{})
+	if err == nil || !strings.Contains(err.Error(), "pending activation") || !strings.Contains(err.Error(), "name") {
+		t.Fatalf("expected a pending activation error naming the changes, got %v", err)
+	}
+}
+
+func TestResourceAdminUpdate_passwordReset(t *testing.T) {
+	var changeRequired string
+	client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/admin/v1/admins/DEAAAAAAAAAAAAAAAAAA":
+			if err := r.ParseForm(); err != nil {
+				t.Error(err)
+			}
+			changeRequired = r.PostForm.Get("password_change_required")
+			fmt.Fprint(w, `{"stat": "OK", "response": {"admin_id": "DEAAAAAAAAAAAAAAAAAA", "role": "Owner"}}`)
+		case r.URL.Path == "/admin/v1/admins/custom_roles":
+			fmt.Fprint(w, `{"stat": "OK", "response": [], "metadata": {}}`)
+		case r.Method == "GET":
+			fmt.Fprint(w, `{"stat": "OK", "response": {"admin_id": "DEAAAAAAAAAAAAAAAAAA", "role": "Owner", "has_external_password_mgmt": false}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			fmt.Fprint(w, `{"stat": "FAIL", "code": 40401, "message": "Resource not found"}`)
+		}
+	})
+	defer closeServer()
+
+	d := schema.TestResourceDataRaw(t, resourceAdmin().Schema, map[string]interface{}{
+		"email":                  "le1f@wut.what",
+		"name":                   "Mister Sir",
+		"phone":                  "+18005551234",
+		"password_reset_trigger": "incident-1",
+	})
+	d.SetId("DEAAAAAAAAAAAAAAAAAA")
+
+	if err := resourceAdminUpdate(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if changeRequired != "true" {
+		t.Errorf("expected the reset trigger to send password_change_required=true, got %q", changeRequired)
+	}
+}
+
+func TestReadAdminRole(t *testing.T) {
+	client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/admin/v1/admins/custom_roles/DR1":
+			fmt.Fprint(w, `{"stat": "OK", "response": {"role_id": "DR1", "name": "Help Desk Leads"}}`)
+		case "/admin/v1/admins/custom_roles":
+			fmt.Fprint(w, `{"stat": "OK", "response": [{"role_id": "DR1", "name": "Help Desk Leads"}, {"role_id": "DR2", "name": "Auditors"}], "metadata": {}}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	})
+	defer closeServer()
+
+	cases := []struct {
+		customRoleID string
+		role         string
+		expectedID   string
+		expectedRole string
+	}{
+		{"DR1", "Help Desk Leads", "DR1", ""},
+		{"DR1", "Auditors", "DR2", ""},
+		{"DR1", "Owner", "", "Owner"},
+		{"", "Help Desk Leads", "DR1", ""},
+		{"", "Owner", "", "Owner"},
+	}
+	for _, c := range cases {
+		d := resourceAdmin().TestResourceData()
+		d.Set("custom_role_id", c.customRoleID)
+		if err := readAdminRole(d, admin.New(*client), c.role); err != nil {
+			t.Fatal(err)
+		}
+		id, role := d.Get("custom_role_id").(string), d.Get("role").(string)
+		if id != c.expectedID || role != c.expectedRole {
+			t.Errorf("role %s with custom_role_id %q: expected %q/%q, got %q/%q", c.role, c.customRoleID, c.expectedID, c.expectedRole, id, role)
+		}
+	}
+}
+
 func TestAccAdmin_import(t *testing.T) {
 	resourceName := "duo_admin.test"
 	rInt := acctest.RandInt()
@@ -63,6 +274,12 @@ func TestAccAdmin_import(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"onboarding",
+					"valid_days",
+					"send_email",
+					"password_change_required",
+				},
 			},
 		},
 	})
@@ -86,7 +303,7 @@ func testAccCheckAdminDestroy(s *terraform.State) error {
 			return err
 		}
 		if result.Stat == "OK" {
-			return fmt.Errorf("Found undeleted admin: %s", result.Response)
+			return fmt.Errorf("Found undeleted admin: %+v", result.Response)
 		}
 	}
 	return nil
@@ -143,3 +360,63 @@ resource "duo_admin" "test" {
 }
 `, rInt)
 }
+
+func testAccCheckAdminConfigOnboarding(rInt int) string {
+	return fmt.Sprintf(`
+resource "duo_admin" "test" {
+  name = "test-%d"
+  email = "le1f@wut.wut"
+  phone = "+12813308004"
+  onboarding = true
+  valid_days = 3
+  send_email = false
+}
+`, rInt)
+}
+
+func testAccCheckAdminConfigDisabled(rInt int) string {
+	return fmt.Sprintf(`
+resource "duo_admin" "test" {
+  name = "test-%d"
+  email = "le1f-disabled@wut.wut"
+  phone = "+12813308004"
+  status = "Disabled"
+}
+`, rInt)
+}
+
+func testAccCheckAdminConfigPassword(rInt int, rotation, reset string) string {
+	return fmt.Sprintf(`
+resource "duo_admin" "test" {
+  name = "test-%d"
+  email = "le1f@wut.wut"
+  phone = "+12813308004"
+  password = "Correct-Horse-Battery-Staple-%d"
+  rotation_trigger = "%s"
+  password_reset_trigger = "%s"
+}
+`, rInt, rInt, rotation, reset)
+}
+
+func testAccCheckAdminConfigRotationWithoutPassword(rInt int) string {
+	return fmt.Sprintf(`
+resource "duo_admin" "test" {
+  name = "test-%d"
+  email = "le1f@wut.wut"
+  phone = "+12813308004"
+  rotation_trigger = "3"
+}
+`, rInt)
+}
+
+func testAccCheckAdminConfigExternalPasswordMgmt(rInt int, password string) string {
+	return fmt.Sprintf(`
+resource "duo_admin" "test" {
+  name = "test-%d"
+  email = "le1f@wut.wut"
+  phone = "+12813308004"
+  password = "%s"
+  has_external_password_mgmt = true
+}
+`, rInt, password)
+}
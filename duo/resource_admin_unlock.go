@@ -0,0 +1,129 @@
+package duo
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceAdminUnlock performs the actions that unlock an admin. Each
+// action runs when the resource is created and again whenever its trigger
+// changes, so an unlock can go through the usual review of a plan.
+func resourceAdminUnlock() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAdminUnlockCreate,
+		Read:   resourceAdminUnlockRead,
+		Update: resourceAdminUnlockUpdate,
+		Delete: resourceAdminUnlockDelete,
+
+		Schema: map[string]*schema.Schema{
+			"admin_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"clear_inactivity_trigger": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"reset_trigger": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+type AdminActionResult struct {
+	duoapi.StatResult
+	Response string
+}
+
+// adminAction calls one of the admin action endpoints, such as
+// clear_inactivity or reset.
+func adminAction(duoAdminClient *admin.Client, adminID, action string) error {
+	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/admins/%s/%s", adminID, action), nil, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+
+	result := &AdminActionResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("could not %s admin %s %s: %s", action, adminID, result.Stat, *result.Message)
+	}
+	return nil
+}
+
+func resourceAdminUnlockCreate(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	adminID := d.Get("admin_id").(string)
+	if d.Get("clear_inactivity_trigger").(string) != "" {
+		if err := adminAction(duoAdminClient, adminID, "clear_inactivity"); err != nil {
+			return err
+		}
+	}
+	if d.Get("reset_trigger").(string) != "" {
+		if err := adminAction(duoAdminClient, adminID, "reset"); err != nil {
+			return err
+		}
+	}
+	d.SetId(adminID)
+	return resourceAdminUnlockRead(d, meta)
+}
+
+func resourceAdminUnlockRead(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	_, body, err := duoAdminClient.SignedCall("GET", fmt.Sprintf("/admin/v1/admins/%s", d.Id()), nil, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+
+	result := &AdminResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		if *result.Message == "Resource not found" {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("could not read admin from duo %s: %s", result.Stat, *result.Message)
+	}
+	return nil
+}
+
+func resourceAdminUnlockUpdate(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	adminID := d.Id()
+	if d.HasChange("clear_inactivity_trigger") && d.Get("clear_inactivity_trigger").(string) != "" {
+		if err := adminAction(duoAdminClient, adminID, "clear_inactivity"); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("reset_trigger") && d.Get("reset_trigger").(string) != "" {
+		if err := adminAction(duoAdminClient, adminID, "reset"); err != nil {
+			return err
+		}
+	}
+	return resourceAdminUnlockRead(d, meta)
+}
+
+func resourceAdminUnlockDelete(d *schema.ResourceData, meta interface{}) error {
+	// Unlocking can't be undone, so there's nothing to do beyond
+	// forgetting about it
+	return nil
+}
@@ -0,0 +1,53 @@
+package duo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAdminUnlock_Basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAdminDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckAdminUnlockConfig(rInt, "ticket-1", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdminExists("duo_admin.test"),
+					resource.TestCheckResourceAttrPair(
+						"duo_admin_unlock.test", "id", "duo_admin.test", "id"),
+					resource.TestCheckResourceAttr(
+						"duo_admin_unlock.test", "clear_inactivity_trigger", "ticket-1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckAdminUnlockConfig(rInt, "ticket-1", "ticket-2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"duo_admin_unlock.test", "reset_trigger", "ticket-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAdminUnlockConfig(rInt int, clearInactivity, reset string) string {
+	return fmt.Sprintf(`
+resource "duo_admin" "test" {
+  name = "test-%d"
+  email = "le1f@wut.wut"
+  phone = "+12813308004"
+}
+
+resource "duo_admin_unlock" "test" {
+  admin_id = "${duo_admin.test.id}"
+  clear_inactivity_trigger = "%s"
+  reset_trigger = "%s"
+}
+`, rInt, clearInactivity, reset)
+}
@@ -0,0 +1,187 @@
+package duo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAdministrativeUnit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAdministrativeUnitCreate,
+		Read:   resourceAdministrativeUnitRead,
+		Update: resourceAdministrativeUnitUpdate,
+		Delete: resourceAdministrativeUnitDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"restrict_by_groups": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"restrict_by_integrations": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"admin_unit_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type AdministrativeUnit struct {
+	AdminUnitID            string   `json:"admin_unit_id"`
+	Name                   string   `json:"name"`
+	Description            string   `json:"description"`
+	RestrictByGroups       bool     `json:"restrict_by_groups"`
+	RestrictByIntegrations bool     `json:"restrict_by_integrations"`
+	Admins                 []string `json:"admins"`
+	Groups                 []string `json:"groups"`
+	Integrations           []string `json:"integrations"`
+}
+
+type AdministrativeUnitResult struct {
+	duoapi.StatResult
+	Response AdministrativeUnit
+}
+
+func getAdministrativeUnit(duoAdminClient *admin.Client, unitID string) (*AdministrativeUnitResult, error) {
+	_, body, err := duoAdminClient.SignedCall("GET", fmt.Sprintf("/admin/v1/administrative_units/%s", unitID), nil, duoapi.UseTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AdministrativeUnitResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func resourceAdministrativeUnitCreate(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	params := url.Values{}
+	params.Set("name", d.Get("name").(string))
+	params.Set("description", d.Get("description").(string))
+	params.Set("restrict_by_groups", boolParser(d.Get("restrict_by_groups")))
+	params.Set("restrict_by_integrations", boolParser(d.Get("restrict_by_integrations")))
+
+	_, body, err := duoAdminClient.SignedCall("POST", "/admin/v1/administrative_units", params, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+
+	result := &AdministrativeUnitResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("could not create administrative unit %s %s", result.Stat, *result.Message)
+	}
+	d.SetId(result.Response.AdminUnitID)
+	return resourceAdministrativeUnitRead(d, meta)
+}
+
+func resourceAdministrativeUnitRead(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	result, err := getAdministrativeUnit(duoAdminClient, d.Id())
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		if *result.Message == "Resource not found" {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("could not read administrative unit from duo %s, %s", result.Stat, *result.Message)
+	}
+
+	d.Set("name", result.Response.Name)
+	d.Set("description", result.Response.Description)
+	d.Set("restrict_by_groups", result.Response.RestrictByGroups)
+	d.Set("restrict_by_integrations", result.Response.RestrictByIntegrations)
+	d.Set("admin_unit_id", result.Response.AdminUnitID)
+	return nil
+}
+
+func resourceAdministrativeUnitUpdate(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	unitID := d.Id()
+	params := url.Values{}
+
+	d.Partial(true)
+	if d.HasChange("name") {
+		params.Set("name", d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Set("description", d.Get("description").(string))
+	}
+	if d.HasChange("restrict_by_groups") {
+		params.Set("restrict_by_groups", boolParser(d.Get("restrict_by_groups")))
+	}
+	if d.HasChange("restrict_by_integrations") {
+		params.Set("restrict_by_integrations", boolParser(d.Get("restrict_by_integrations")))
+	}
+
+	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/administrative_units/%s", unitID), params, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+	result := &AdministrativeUnitResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("there was a problem updating administrative unit %s: %s", unitID, *result.Message)
+	}
+	d.Partial(false)
+	return resourceAdministrativeUnitRead(d, meta)
+}
+
+func resourceAdministrativeUnitDelete(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	unitID := d.Id()
+	_, body, err := duoAdminClient.SignedCall("DELETE", fmt.Sprintf("/admin/v1/administrative_units/%s", unitID), nil, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+	var result deleteResult
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("there was a problem deleting administrative unit %s: %s", unitID, *result.Message)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package duo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// administrativeUnitMember describes one kind of object that can be
+// assigned to an administrative unit.
+type administrativeUnitMember struct {
+	// kind is the path segment used by the add and remove endpoints.
+	kind string
+	// attribute is the schema key holding the member's ID.
+	attribute string
+	// members returns the IDs of this kind assigned to a unit.
+	members func(AdministrativeUnit) []string
+}
+
+var (
+	administrativeUnitAdmin = administrativeUnitMember{
+		kind:      "admin",
+		attribute: "admin_id",
+		members:   func(u AdministrativeUnit) []string { return u.Admins },
+	}
+	administrativeUnitGroup = administrativeUnitMember{
+		kind:      "group",
+		attribute: "group_id",
+		members:   func(u AdministrativeUnit) []string { return u.Groups },
+	}
+	administrativeUnitIntegration = administrativeUnitMember{
+		kind:      "integration",
+		attribute: "integration_key",
+		members:   func(u AdministrativeUnit) []string { return u.Integrations },
+	}
+)
+
+func resourceAdministrativeUnitAdmin() *schema.Resource {
+	return resourceAdministrativeUnitMember(administrativeUnitAdmin)
+}
+
+func resourceAdministrativeUnitGroup() *schema.Resource {
+	return resourceAdministrativeUnitMember(administrativeUnitGroup)
+}
+
+func resourceAdministrativeUnitIntegration() *schema.Resource {
+	return resourceAdministrativeUnitMember(administrativeUnitIntegration)
+}
+
+func resourceAdministrativeUnitMember(m administrativeUnitMember) *schema.Resource {
+	return &schema.Resource{
+		Create: m.create,
+		Read:   m.read,
+		Delete: m.delete,
+
+		Importer: &schema.ResourceImporter{
+			State: m.importState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"admin_unit_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			m.attribute: &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func (m administrativeUnitMember) path(d *schema.ResourceData) string {
+	return fmt.Sprintf("/admin/v1/administrative_units/%s/%s/%s", d.Get("admin_unit_id").(string), m.kind, d.Get(m.attribute).(string))
+}
+
+func (m administrativeUnitMember) create(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	unitID := d.Get("admin_unit_id").(string)
+	memberID := d.Get(m.attribute).(string)
+	_, body, err := duoAdminClient.SignedCall("POST", m.path(d), nil, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+
+	result := &AdministrativeUnitResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("could not add %s %s to administrative unit %s: %s", m.kind, memberID, unitID, *result.Message)
+	}
+	d.SetId(fmt.Sprintf("%s:%s", unitID, memberID))
+	return m.read(d, meta)
+}
+
+func (m administrativeUnitMember) read(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	unitID := d.Get("admin_unit_id").(string)
+	memberID := d.Get(m.attribute).(string)
+	result, err := getAdministrativeUnit(duoAdminClient, unitID)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		if *result.Message == "Resource not found" {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("could not read administrative unit from duo %s, %s", result.Stat, *result.Message)
+	}
+
+	for _, id := range m.members(result.Response) {
+		if id == memberID {
+			return nil
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+func (m administrativeUnitMember) delete(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	unitID := d.Get("admin_unit_id").(string)
+	memberID := d.Get(m.attribute).(string)
+	_, body, err := duoAdminClient.SignedCall("DELETE", m.path(d), nil, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+
+	var result deleteResult
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("there was a problem removing %s %s from administrative unit %s: %s", m.kind, memberID, unitID, *result.Message)
+	}
+	return nil
+}
+
+func (m administrativeUnitMember) importState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected admin_unit_id:%s", d.Id(), m.attribute)
+	}
+	d.Set("admin_unit_id", parts[0])
+	d.Set(m.attribute, parts[1])
+	return []*schema.ResourceData{d}, nil
+}
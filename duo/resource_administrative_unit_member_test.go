@@ -0,0 +1,112 @@
+package duo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAdministrativeUnitIntegration_Basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAdministrativeUnitDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckAdministrativeUnitMemberConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdministrativeUnitMemberExists("duo_administrative_unit_integration.test", administrativeUnitIntegration),
+					testAccCheckAdministrativeUnitMemberExists("duo_administrative_unit_admin.test", administrativeUnitAdmin),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAdministrativeUnitIntegration_import(t *testing.T) {
+	resourceName := "duo_administrative_unit_integration.test"
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAdministrativeUnitDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckAdministrativeUnitMemberConfig(rInt),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAdministrativeUnitMemberExists(n string, m administrativeUnitMember) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+		duoclient := testAccProvider.Meta().(*duoapi.DuoApi)
+		duoAdminClient := admin.New(*duoclient)
+
+		unitID := rs.Primary.Attributes["admin_unit_id"]
+		memberID := rs.Primary.Attributes[m.attribute]
+		result, err := getAdministrativeUnit(duoAdminClient, unitID)
+		if err != nil {
+			return err
+		}
+		if result.Stat != "OK" {
+			return fmt.Errorf("Could not find administrative unit %s %s", result.Stat, *result.Message)
+		}
+
+		for _, id := range m.members(result.Response) {
+			if id == memberID {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s %s is not a member of administrative unit %s", m.kind, memberID, unitID)
+	}
+}
+
+func testAccCheckAdministrativeUnitMemberConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "duo_administrative_unit" "test" {
+  name = "test-unit-%d"
+  description = "emea admins"
+  restrict_by_integrations = true
+}
+
+resource "duo_integration" "test" {
+  name = "test-integration-%d"
+  type = "authapi"
+}
+
+resource "duo_admin" "test" {
+  name = "test-%d"
+  email = "le1f@wut.wut"
+  phone = "+12813308004"
+}
+
+resource "duo_administrative_unit_integration" "test" {
+  admin_unit_id = "${duo_administrative_unit.test.id}"
+  integration_key = "${duo_integration.test.id}"
+}
+
+resource "duo_administrative_unit_admin" "test" {
+  admin_unit_id = "${duo_administrative_unit.test.id}"
+  admin_id = "${duo_admin.test.id}"
+}
+`, rInt, rInt, rInt)
+}
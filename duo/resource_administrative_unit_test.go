@@ -0,0 +1,138 @@
+package duo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAdministrativeUnit_Basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAdministrativeUnitDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckAdministrativeUnitConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdministrativeUnitExists("duo_administrative_unit.test"),
+					resource.TestCheckResourceAttr(
+						"duo_administrative_unit.test", "name", fmt.Sprintf("test-unit-%d", rInt)),
+					resource.TestCheckResourceAttr(
+						"duo_administrative_unit.test", "description", "emea admins"),
+					resource.TestCheckResourceAttr(
+						"duo_administrative_unit.test", "restrict_by_groups", "false"),
+					resource.TestCheckResourceAttr(
+						"duo_administrative_unit.test", "restrict_by_integrations", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckAdministrativeUnitConfigUpdated(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdministrativeUnitExists("duo_administrative_unit.test"),
+					resource.TestCheckResourceAttr(
+						"duo_administrative_unit.test", "name", fmt.Sprintf("test-unit-updated-%d", rInt)),
+					resource.TestCheckResourceAttr(
+						"duo_administrative_unit.test", "description", "emea and apac admins"),
+					resource.TestCheckResourceAttr(
+						"duo_administrative_unit.test", "restrict_by_groups", "true"),
+					resource.TestCheckResourceAttr(
+						"duo_administrative_unit.test", "restrict_by_integrations", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAdministrativeUnit_import(t *testing.T) {
+	resourceName := "duo_administrative_unit.test"
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAdministrativeUnitDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckAdministrativeUnitConfig(rInt),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAdministrativeUnitDestroy(s *terraform.State) error {
+	duoclient := testAccProvider.Meta().(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "duo_administrative_unit" {
+			continue
+		}
+
+		result, err := getAdministrativeUnit(duoAdminClient, r.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if result.Stat == "OK" {
+			return fmt.Errorf("Found administrative unit when it should have been deleted: %+v", result.Response)
+		}
+	}
+	return nil
+}
+
+func testAccCheckAdministrativeUnitExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+		duoclient := testAccProvider.Meta().(*duoapi.DuoApi)
+		duoAdminClient := admin.New(*duoclient)
+
+		result, err := getAdministrativeUnit(duoAdminClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if result.Stat != "OK" {
+			return fmt.Errorf("Could not find administrative unit %s %s", result.Stat, *result.Message)
+		}
+
+		if result.Response.AdminUnitID != rs.Primary.ID {
+			return fmt.Errorf("Administrative unit not found: %v - %v", rs.Primary.ID, result.Response.AdminUnitID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckAdministrativeUnitConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "duo_administrative_unit" "test" {
+  name = "test-unit-%d"
+  description = "emea admins"
+  restrict_by_integrations = true
+}
+`, rInt)
+}
+
+func testAccCheckAdministrativeUnitConfigUpdated(rInt int) string {
+	return fmt.Sprintf(`
+resource "duo_administrative_unit" "test" {
+  name = "test-unit-updated-%d"
+  description = "emea and apac admins"
+  restrict_by_groups = true
+  restrict_by_integrations = true
+}
+`, rInt)
+}
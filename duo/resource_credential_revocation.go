@@ -0,0 +1,221 @@
+package duo
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// revokedCredential describes the credential being revoked, looked up
+// before it's deleted so the plan and state can show what it was.
+type revokedCredential struct {
+	Label     string
+	UserID    string
+	Username  string
+	DateAdded int64
+}
+
+// credentialRevocation describes one kind of credential that can be
+// revoked.
+type credentialRevocation struct {
+	// kind names the credential in messages.
+	kind string
+	// attribute is the schema key holding the credential's ID.
+	attribute string
+	// path is the format of the credential's endpoint, given its ID.
+	path string
+	// lookup returns the credential, or nil if it doesn't exist.
+	lookup func(*admin.Client, string) (*revokedCredential, error)
+}
+
+var (
+	webAuthnCredentialRevocation = credentialRevocation{
+		kind:      "webauthn credential",
+		attribute: "webauthnkey",
+		path:      "/admin/v1/webauthncredentials/%s",
+		lookup:    lookupWebAuthnCredential,
+	}
+	u2fTokenRevocation = credentialRevocation{
+		kind:      "u2f token",
+		attribute: "registration_id",
+		path:      "/admin/v1/u2ftokens/%s",
+		lookup:    lookupU2FToken,
+	}
+)
+
+func resourceWebAuthnCredentialRevocation() *schema.Resource {
+	return resourceCredentialRevocation(webAuthnCredentialRevocation)
+}
+
+func resourceU2FTokenRevocation() *schema.Resource {
+	return resourceCredentialRevocation(u2fTokenRevocation)
+}
+
+func resourceCredentialRevocation(c credentialRevocation) *schema.Resource {
+	return &schema.Resource{
+		Create: c.create,
+		Read:   c.read,
+		Delete: c.delete,
+
+		CustomizeDiff: c.customizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			c.attribute: &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"label": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"username": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_added": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WebAuthnCredentialResult struct {
+	duoapi.StatResult
+	Response WebAuthnCredential
+}
+
+func lookupWebAuthnCredential(duoAdminClient *admin.Client, key string) (*revokedCredential, error) {
+	_, body, err := duoAdminClient.SignedCall("GET", fmt.Sprintf("/admin/v1/webauthncredentials/%s", key), nil, duoapi.UseTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &WebAuthnCredentialResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Stat != "OK" {
+		if *result.Message == "Resource not found" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read webauthn credential %s %s, %s", key, result.Stat, *result.Message)
+	}
+
+	credential := &revokedCredential{
+		Label:     result.Response.Label,
+		DateAdded: result.Response.DateAdded,
+	}
+	if result.Response.User != nil {
+		credential.UserID = result.Response.User.UserID
+		credential.Username = result.Response.User.Username
+	}
+	return credential, nil
+}
+
+func lookupU2FToken(duoAdminClient *admin.Client, registrationID string) (*revokedCredential, error) {
+	result, err := duoAdminClient.GetU2FToken(registrationID)
+	if err != nil {
+		return nil, err
+	}
+	if result.Stat != "OK" {
+		if *result.Message == "Resource not found" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read u2f token %s %s, %s", registrationID, result.Stat, *result.Message)
+	}
+	if len(result.Response) == 0 {
+		return nil, nil
+	}
+
+	// U2F tokens don't have labels, so the label is left empty
+	token := result.Response[0]
+	credential := &revokedCredential{
+		DateAdded: int64(token.DateAdded),
+	}
+	if token.User != nil {
+		credential.UserID = token.User.UserID
+		credential.Username = token.User.Username
+	}
+	return credential, nil
+}
+
+// customizeDiff shows the label and owner of the credential being revoked
+// in the plan, including when the revocation is replaced by a new one.
+func (c credentialRevocation) customizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if (d.Id() != "" && !d.HasChange(c.attribute)) || !d.NewValueKnown(c.attribute) {
+		return nil
+	}
+
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	id := d.Get(c.attribute).(string)
+	credential, err := c.lookup(duoAdminClient, id)
+	if err != nil {
+		return err
+	}
+	if credential == nil {
+		return fmt.Errorf("could not find %s %s to revoke", c.kind, id)
+	}
+	d.SetNew("label", credential.Label)
+	d.SetNew("user_id", credential.UserID)
+	d.SetNew("username", credential.Username)
+	d.SetNew("date_added", secondsToTime(credential.DateAdded))
+	return nil
+}
+
+func (c credentialRevocation) create(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	id := d.Get(c.attribute).(string)
+	credential, err := c.lookup(duoAdminClient, id)
+	if err != nil {
+		return err
+	}
+	if credential == nil {
+		return fmt.Errorf("could not find %s %s to revoke", c.kind, id)
+	}
+
+	_, body, err := duoAdminClient.SignedCall("DELETE", fmt.Sprintf(c.path, id), nil, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+
+	var result deleteResult
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("could not revoke %s %s %s: %s", c.kind, id, result.Stat, *result.Message)
+	}
+
+	d.SetId(id)
+	d.Set("label", credential.Label)
+	d.Set("user_id", credential.UserID)
+	d.Set("username", credential.Username)
+	d.Set("date_added", secondsToTime(credential.DateAdded))
+	return c.read(d, meta)
+}
+
+func (c credentialRevocation) read(d *schema.ResourceData, meta interface{}) error {
+	// The credential no longer exists once revoked, so state only records
+	// what was revoked
+	return nil
+}
+
+func (c credentialRevocation) delete(d *schema.ResourceData, meta interface{}) error {
+	// A revoked credential can't be restored
+	return nil
+}
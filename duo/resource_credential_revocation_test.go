@@ -0,0 +1,128 @@
+package duo
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceCredentialRevocationCreate(t *testing.T) {
+	cases := []struct {
+		revocation credentialRevocation
+		id         string
+		credential string
+		label      string
+	}{
+		{
+			webAuthnCredentialRevocation,
+			"WAAAAAAAAAAAAAAAAAAA",
+			`{"webauthnkey": "WAAAAAAAAAAAAAAAAAAA", "label": "Security Key", "date_added": 1550000000, "user": {"user_id": "DU1", "username": "one"}}`,
+			"Security Key",
+		},
+		{
+			u2fTokenRevocation,
+			"D2AAAAAAAAAAAAAAAAAA",
+			`[{"registration_id": "D2AAAAAAAAAAAAAAAAAA", "date_added": 1550000000, "user": {"user_id": "DU1", "username": "one"}}]`,
+			"",
+		},
+	}
+
+	for _, c := range cases {
+		var revoked bool
+		client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasSuffix(r.URL.Path, "/"+c.id) {
+				t.Errorf("unexpected request to %s", r.URL.Path)
+			}
+			switch r.Method {
+			case "GET":
+				fmt.Fprint(w, `{"stat": "OK", "response": `+c.credential+`}`)
+			case "DELETE":
+				revoked = true
+				fmt.Fprint(w, `{"stat": "OK", "response": ""}`)
+			}
+		})
+
+		d := resourceCredentialRevocation(c.revocation).TestResourceData()
+		d.Set(c.revocation.attribute, c.id)
+		err := c.revocation.create(d, client)
+		closeServer()
+		if err != nil {
+			t.Fatalf("%s: %s", c.revocation.kind, err)
+		}
+
+		if !revoked {
+			t.Errorf("%s: expected %s to be revoked", c.revocation.kind, c.id)
+		}
+		if d.Id() != c.id {
+			t.Errorf("%s: expected ID %s, got %s", c.revocation.kind, c.id, d.Id())
+		}
+		if actual := d.Get("label").(string); actual != c.label {
+			t.Errorf("%s: expected label %q, got %q", c.revocation.kind, c.label, actual)
+		}
+		if d.Get("user_id").(string) != "DU1" || d.Get("username").(string) != "one" {
+			t.Errorf("%s: expected owner DU1 (one), got %s (%s)", c.revocation.kind, d.Get("user_id"), d.Get("username"))
+		}
+		if actual := d.Get("date_added").(string); actual != "2019-02-12T19:33:20Z" {
+			t.Errorf("%s: unexpected date_added %s", c.revocation.kind, actual)
+		}
+	}
+}
+
+func TestResourceCredentialRevocationCreate_notFound(t *testing.T) {
+	client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, `{"stat": "FAIL", "code": 40401, "message": "Resource not found"}`)
+	})
+	defer closeServer()
+
+	d := resourceWebAuthnCredentialRevocation().TestResourceData()
+	d.Set("webauthnkey", "WAAAAAAAAAAAAAAAAAAA")
+	err := webAuthnCredentialRevocation.create(d, client)
+	if err == nil || !strings.Contains(err.Error(), "could not find webauthn credential") {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestResourceCredentialRevocationDiff_replaced(t *testing.T) {
+	client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/WA2") {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"stat": "OK", "response": {"webauthnkey": "WA2", "label": "Spare Key", "date_added": 1550000000, "user": {"user_id": "DU2", "username": "two"}}}`)
+	})
+	defer closeServer()
+
+	state := &terraform.InstanceState{
+		ID: "WA1",
+		Attributes: map[string]string{
+			"webauthnkey": "WA1",
+			"label":       "Security Key",
+			"user_id":     "DU1",
+			"username":    "one",
+		},
+	}
+	raw, err := config.NewRawConfig(map[string]interface{}{"webauthnkey": "WA2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := resourceCredentialRevocation(webAuthnCredentialRevocation).Diff(state, terraform.NewResourceConfig(raw), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.RequiresNew() {
+		t.Fatal("expected a new credential to replace the revocation")
+	}
+	if attr := diff.Attributes["label"]; attr == nil || attr.New != "Spare Key" {
+		t.Errorf("expected the new credential's label in the plan, got %+v", attr)
+	}
+	if attr := diff.Attributes["username"]; attr == nil || attr.New != "two" {
+		t.Errorf("expected the new credential's owner in the plan, got %+v", attr)
+	}
+}
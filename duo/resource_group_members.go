@@ -0,0 +1,227 @@
+package duo
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"sync"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// groupMembersParallelism bounds the number of membership changes sent to
+// Duo at once.
+const groupMembersParallelism = 10
+
+// resourceGroupMembers authoritatively manages the users in a group,
+// removing any that aren't listed.
+func resourceGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGroupMembersCreate,
+		Read:   resourceGroupMembersRead,
+		Update: resourceGroupMembersUpdate,
+		Delete: resourceGroupMembersDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+type GroupMember struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+type GroupMembersResult struct {
+	duoapi.StatResult
+	admin.ListResult
+	Response []GroupMember
+}
+
+// getGroupMembers pages through the users in a group, returning nil if the
+// group doesn't exist.
+func getGroupMembers(duoAdminClient *admin.Client, gid string) ([]string, error) {
+	userIDs := []string{}
+	params := url.Values{}
+	params.Set("limit", "500")
+	params.Set("offset", "0")
+	for {
+		_, body, err := duoAdminClient.SignedCall("GET", fmt.Sprintf("/admin/v2/groups/%s/users", gid), params, duoapi.UseTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		result := &GroupMembersResult{}
+		err = json.Unmarshal(body, result)
+		if err != nil {
+			return nil, err
+		}
+		if result.Stat != "OK" {
+			if *result.Message == "Resource not found" {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("could not read members of group %s %s, %s", gid, result.Stat, *result.Message)
+		}
+		for _, m := range result.Response {
+			userIDs = append(userIDs, m.UserID)
+		}
+
+		nextOffset := result.Metadata.NextOffset.String()
+		if nextOffset == "" {
+			return userIDs, nil
+		}
+		params.Set("offset", nextOffset)
+	}
+}
+
+func resourceGroupMembersCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("group_id").(string))
+	return resourceGroupMembersUpdate(d, meta)
+}
+
+func resourceGroupMembersRead(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	gid := d.Id()
+	userIDs, err := getGroupMembers(duoAdminClient, gid)
+	if err != nil {
+		return err
+	}
+	if userIDs == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group_id", gid)
+	d.Set("user_ids", userIDs)
+	return nil
+}
+
+func resourceGroupMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	gid := d.Id()
+	current, err := getGroupMembers(duoAdminClient, gid)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return fmt.Errorf("could not find group %s", gid)
+	}
+
+	add, remove := stringSetDiff(current, expandStringSet(d.Get("user_ids").(*schema.Set)))
+	log.Printf("[DEBUG] adding %d and removing %d members of group %s", len(add), len(remove), gid)
+	err = forEachParallel(add, func(uid string) error {
+		return addGroupMember(duoAdminClient, gid, uid)
+	})
+	if err != nil {
+		return err
+	}
+	err = forEachParallel(remove, func(uid string) error {
+		return removeGroupMember(duoAdminClient, gid, uid)
+	})
+	if err != nil {
+		return err
+	}
+	return resourceGroupMembersRead(d, meta)
+}
+
+func resourceGroupMembersDelete(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	gid := d.Id()
+	return forEachParallel(expandStringSet(d.Get("user_ids").(*schema.Set)), func(uid string) error {
+		return removeGroupMember(duoAdminClient, gid, uid)
+	})
+}
+
+// forEachParallel calls fn for each value, at most groupMembersParallelism
+// at a time, and returns every error encountered.
+func forEachParallel(values []string, fn func(string) error) error {
+	var (
+		errs error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	sem := make(chan struct{}, groupMembersParallelism)
+	for _, v := range values {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(v string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := fn(v); err != nil {
+				mu.Lock()
+				errs = multierror.Append(errs, err)
+				mu.Unlock()
+			}
+		}(v)
+	}
+	wg.Wait()
+	return errs
+}
+
+func addGroupMember(duoAdminClient *admin.Client, gid, uid string) error {
+	params := url.Values{}
+	params.Set("group_id", gid)
+	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/users/%s/groups", uid), params, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+
+	var result deleteResult
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("could not add user %s to group %s: %s", uid, gid, *result.Message)
+	}
+	return nil
+}
+
+// removeGroupMember removes a user from a group. A user that's already left
+// the group, or that's been deleted, is treated as removed.
+func removeGroupMember(duoAdminClient *admin.Client, gid, uid string) error {
+	_, body, err := duoAdminClient.SignedCall("DELETE", fmt.Sprintf("/admin/v1/users/%s/groups/%s", uid, gid), nil, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+
+	var result deleteResult
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		if *result.Message == "Resource not found" {
+			return nil
+		}
+		return fmt.Errorf("could not remove user %s from group %s: %s", uid, gid, *result.Message)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package duo
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestResourceGroupMembersUpdate(t *testing.T) {
+	const gid = "DGAAAAAAAAAAAAAAAAAA"
+
+	var (
+		mu      sync.Mutex
+		added   []string
+		removed []string
+	)
+	client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/admin/v2/groups/"+gid+"/users":
+			// The members are returned over two pages to exercise pagination.
+			if r.URL.Query().Get("offset") == "0" {
+				fmt.Fprint(w, `{"stat": "OK", "response": [{"user_id": "DU1"}, {"user_id": "DU2"}], "metadata": {"next_offset": 2}}`)
+				return
+			}
+			fmt.Fprint(w, `{"stat": "OK", "response": [{"user_id": "DU3"}], "metadata": {}}`)
+		case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/groups"):
+			if err := r.ParseForm(); err != nil || r.PostForm.Get("group_id") != gid {
+				t.Errorf("unexpected add request %s %v", r.URL.Path, r.PostForm)
+			}
+			added = append(added, strings.Split(r.URL.Path, "/")[4])
+			fmt.Fprint(w, `{"stat": "OK", "response": ""}`)
+		case r.Method == "DELETE" && strings.HasSuffix(r.URL.Path, "/groups/"+gid):
+			removed = append(removed, strings.Split(r.URL.Path, "/")[4])
+			fmt.Fprint(w, `{"stat": "OK", "response": ""}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			fmt.Fprint(w, `{"stat": "FAIL", "code": 40401, "message": "Resource not found"}`)
+		}
+	})
+	defer closeServer()
+
+	d := resourceGroupMembers().TestResourceData()
+	d.SetId(gid)
+	d.Set("group_id", gid)
+	d.Set("user_ids", []string{"DU2", "DU3", "DU4", "DU5"})
+
+	if err := resourceGroupMembersUpdate(d, client); err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(added)
+	if expected := []string{"DU4", "DU5"}; !reflect.DeepEqual(added, expected) {
+		t.Errorf("expected %v to be added, got %v", expected, added)
+	}
+	if expected := []string{"DU1"}; !reflect.DeepEqual(removed, expected) {
+		t.Errorf("expected %v to be removed, got %v", expected, removed)
+	}
+}
+
+func TestResourceGroupMembersDelete_notFound(t *testing.T) {
+	client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, `{"stat": "FAIL", "code": 40401, "message": "Resource not found"}`)
+	})
+	defer closeServer()
+
+	d := resourceGroupMembers().TestResourceData()
+	d.SetId("DGAAAAAAAAAAAAAAAAAA")
+	d.Set("user_ids", []string{"DU1", "DU2"})
+
+	if err := resourceGroupMembersDelete(d, client); err != nil {
+		t.Fatalf("expected members that are already gone to be ignored, got %v", err)
+	}
+}
+
+func TestForEachParallel(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		running int
+		peak    int
+	)
+	values := make([]string, groupMembersParallelism*3)
+	for i := range values {
+		values[i] = fmt.Sprintf("DU%d", i)
+	}
+
+	err := forEachParallel(values, func(v string) error {
+		mu.Lock()
+		running++
+		if running > peak {
+			peak = running
+		}
+		mu.Unlock()
+
+		defer func() {
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+		if v == "DU1" || v == "DU2" {
+			return fmt.Errorf("failed %s", v)
+		}
+		return nil
+	})
+
+	if peak > groupMembersParallelism {
+		t.Errorf("expected at most %d calls at once, got %d", groupMembersParallelism, peak)
+	}
+	if err == nil || !strings.Contains(err.Error(), "2 errors occurred") {
+		t.Errorf("expected both errors to be returned, got %v", err)
+	}
+}
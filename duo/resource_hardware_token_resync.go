@@ -0,0 +1,102 @@
+package duo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceHardwareTokenResync resyncs a hardware token using three
+// consecutive codes it generated. Changing the codes resyncs it again.
+func resourceHardwareTokenResync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceHardwareTokenResyncCreate,
+		Read:   resourceHardwareTokenResyncRead,
+		Delete: resourceHardwareTokenResyncDelete,
+
+		Schema: map[string]*schema.Schema{
+			"token_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"code1": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"code2": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"code3": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+type TokenResyncResult struct {
+	duoapi.StatResult
+	Response string
+}
+
+func resourceHardwareTokenResyncCreate(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	tokenID := d.Get("token_id").(string)
+	params := url.Values{}
+	params.Set("code1", d.Get("code1").(string))
+	params.Set("code2", d.Get("code2").(string))
+	params.Set("code3", d.Get("code3").(string))
+
+	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/tokens/%s/resync", tokenID), params, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+	result := &TokenResyncResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("could not resync token %s %s: %s", tokenID, result.Stat, *result.Message)
+	}
+
+	d.SetId(tokenID)
+	return resourceHardwareTokenResyncRead(d, meta)
+}
+
+func resourceHardwareTokenResyncRead(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	result, err := duoAdminClient.GetToken(d.Id())
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		if *result.Message == "Resource not found" {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("could not read token from duo %s, %s", result.Stat, *result.Message)
+	}
+	return nil
+}
+
+func resourceHardwareTokenResyncDelete(d *schema.ResourceData, meta interface{}) error {
+	// A resync can't be undone
+	return nil
+}
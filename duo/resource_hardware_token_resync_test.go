@@ -0,0 +1,42 @@
+package duo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestResourceHardwareTokenResyncCreate(t *testing.T) {
+	const tid = "DHAAAAAAAAAAAAAAAAAA"
+	client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/admin/v1/tokens/" + tid + "/resync":
+			if err := r.ParseForm(); err != nil {
+				t.Error(err)
+			}
+			for i, code := range []string{"123456", "234567", "345678"} {
+				if actual := r.PostForm.Get(fmt.Sprintf("code%d", i+1)); actual != code {
+					t.Errorf("expected code%d to be %s, got %s", i+1, code, actual)
+				}
+			}
+			fmt.Fprint(w, `{"stat": "OK", "response": ""}`)
+		case "/admin/v1/tokens/" + tid:
+			fmt.Fprint(w, `{"stat": "OK", "response": {"token_id": "`+tid+`"}}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	})
+	defer closeServer()
+
+	d := resourceHardwareTokenResync().TestResourceData()
+	d.Set("token_id", tid)
+	d.Set("code1", "123456")
+	d.Set("code2", "234567")
+	d.Set("code3", "345678")
+	if err := resourceHardwareTokenResyncCreate(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != tid {
+		t.Errorf("expected ID %s, got %s", tid, d.Id())
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/duosecurity/duo_api_golang"
 	admin "github.com/duosecurity/duo_api_golang/admin"
@@ -18,10 +19,10 @@ func resourceIntegration() *schema.Resource {
 		Delete: resourceIntegrationDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceIntegrationImport,
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: driftAttributionSchema(map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
@@ -35,7 +36,7 @@ func resourceIntegration() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-		},
+		}),
 	}
 }
 
@@ -51,6 +52,12 @@ type IntegrationResult struct {
 	Response Integration
 }
 
+type IntegrationsResult struct {
+	duoapi.StatResult
+	admin.ListResult
+	Response []Integration
+}
+
 func resourceIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
 	duoclient := meta.(*duoapi.DuoApi)
 	duoAdminClient := admin.New(*duoclient)
@@ -76,6 +83,69 @@ func resourceIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
 	return resourceIntegrationRead(d, meta)
 }
 
+// resourceIntegrationImport accepts either an integration key or
+// name:<name>.
+func resourceIntegrationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !strings.HasPrefix(d.Id(), "name:") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	name := strings.TrimPrefix(d.Id(), "name:")
+	integration, err := findIntegrationByName(duoAdminClient, name)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(integration.IKey)
+	return []*schema.ResourceData{d}, nil
+}
+
+// findIntegrationByName pages through the integrations looking for the one
+// named name, erroring if there isn't exactly one.
+func findIntegrationByName(duoAdminClient *admin.Client, name string) (*Integration, error) {
+	var found []Integration
+	params := url.Values{}
+	params.Set("limit", "300")
+	params.Set("offset", "0")
+	for {
+		_, body, err := duoAdminClient.SignedCall("GET", "/admin/v1/integrations", params, duoapi.UseTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		result := &IntegrationsResult{}
+		err = json.Unmarshal(body, result)
+		if err != nil {
+			return nil, err
+		}
+		if result.Stat != "OK" {
+			return nil, fmt.Errorf("could not list integrations %s: %s", result.Stat, *result.Message)
+		}
+		for _, i := range result.Response {
+			if i.Name == name {
+				found = append(found, i)
+			}
+		}
+
+		nextOffset := result.Metadata.NextOffset.String()
+		if nextOffset == "" {
+			break
+		}
+		params.Set("offset", nextOffset)
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("could not find an integration named %q", name)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("found %d integrations named %q, import by integration key instead", len(found), name)
+	}
+}
+
 func resourceIntegrationRead(d *schema.ResourceData, meta interface{}) error {
 	duoclient := meta.(*duoapi.DuoApi)
 	duoAdminClient := admin.New(*duoclient)
@@ -100,6 +170,11 @@ func resourceIntegrationRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("could not read integration from duo %s, %s", result.Stat, *result.Message)
 	}
 
+	attributeDrift(d, duoclient, map[string]string{
+		"name": result.Response.Name,
+		"type": result.Response.Type,
+	}, "integration_", d.Get("name").(string), result.Response.Name)
+
 	d.Set("name", result.Response.Name)
 	d.Set("type", result.Response.Type)
 	d.Set("ikey", result.Response.IKey)
@@ -59,6 +59,9 @@ func TestAccIntegration_import(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"attribute_drift",
+				},
 			},
 		},
 	})
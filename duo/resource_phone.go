@@ -3,6 +3,7 @@ package duo
 import (
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/url"
 	"strconv"
 	"strings"
@@ -20,13 +21,14 @@ func resourcePhone() *schema.Resource {
 		Delete: resourcePhoneDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourcePhoneImport,
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: driftAttributionSchema(map[string]*schema.Schema{
 			"number": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentPhoneNumbers,
 			},
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -57,7 +59,75 @@ func resourcePhone() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-		},
+			"adopt_existing": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"activation_trigger": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sms_activation_trigger": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"activation_install": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"activation_valid_secs": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      86400,
+				ValidateFunc: validatePositiveInt,
+			},
+			"activation_url": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"activation_barcode": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"installation_url": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"activated": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"capabilities": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"encrypted": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fingerprint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"screenlock": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sms_passcodes_sent": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"model": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}),
 	}
 }
 
@@ -87,6 +157,21 @@ func resourcePhoneCreate(d *schema.ResourceData, meta interface{}) error {
 	if postdelay, ok := d.GetOk("postdelay"); ok {
 		params.Set("postdelay", postdelay.(string))
 	}
+
+	number := d.Get("number").(string)
+	extension := d.Get("extension").(string)
+	if d.Get("adopt_existing").(bool) && number != "" {
+		existing, err := findPhoneByNumber(duoAdminClient, number, extension)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			log.Printf("[INFO] adopting existing phone %s (%s)", existing.Number, existing.PhoneID)
+			d.SetId(existing.PhoneID)
+			return resourcePhoneReconcile(d, meta, params)
+		}
+	}
+
 	_, body, err := duoAdminClient.SignedCall("POST", "/admin/v1/phones", params, duoapi.UseTimeout)
 	if err != nil {
 		return err
@@ -98,25 +183,155 @@ func resourcePhoneCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if result.Stat != "OK" {
-		code := strconv.Itoa(int(*result.Code))
-		if strings.HasPrefix(code, "400") {
-			d.SetId("")
-			return nil
+		if number != "" {
+			if existing, _ := findPhoneByNumber(duoAdminClient, number, extension); existing != nil {
+				return fmt.Errorf("could not create phone %s, it already exists as %s; set adopt_existing or import it", number, existing.PhoneID)
+			}
 		}
 		return fmt.Errorf("could not create phone %s %s", result.Stat, *result.Message)
 	}
 
 	d.SetId(result.Response.PhoneID)
+	if err := resourcePhoneActivate(d, duoAdminClient, true); err != nil {
+		return err
+	}
+	return resourcePhoneRead(d, meta)
+}
+
+// findPhoneByNumber returns the phone with number and extension, or nil if
+// there isn't one.
+func findPhoneByNumber(duoAdminClient *admin.Client, number, extension string) (*admin.Phone, error) {
+	options := []func(*url.Values){admin.GetPhonesNumber(normalizePhoneNumber(number))}
+	if extension != "" {
+		options = append(options, admin.GetPhonesExtension(extension))
+	}
+	result, err := duoAdminClient.GetPhones(options...)
+	if err != nil {
+		return nil, err
+	}
+	if result.Stat != "OK" {
+		return nil, fmt.Errorf("could not look up phone %s %s: %s", number, result.Stat, *result.Message)
+	}
+	for _, p := range result.Response {
+		if p.Extension == extension {
+			return &p, nil
+		}
+	}
+	return nil, nil
+}
+
+// resourcePhoneImport accepts either a phone ID or number:<e164>[/ext].
+func resourcePhoneImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !strings.HasPrefix(d.Id(), "number:") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	number, extension := splitPhoneNumber(strings.TrimPrefix(d.Id(), "number:"))
+	phone, err := findPhoneByNumber(duoAdminClient, number, extension)
+	if err != nil {
+		return nil, err
+	}
+	if phone == nil {
+		return nil, fmt.Errorf("could not find a phone with number %q and extension %q", number, extension)
+	}
+	d.SetId(phone.PhoneID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// splitPhoneNumber splits a number with an optional /ext suffix.
+func splitPhoneNumber(s string) (string, string) {
+	parts := strings.SplitN(s, "/", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// resourcePhoneReconcile applies the configured attributes to an existing
+// phone that's been adopted.
+func resourcePhoneReconcile(d *schema.ResourceData, meta interface{}, params url.Values) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	pid := d.Id()
+	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/phones/%s", pid), params, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+	result := &admin.GetPhoneResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("there was a problem updating existing phone %s: %s", pid, *result.Message)
+	}
+	if err := resourcePhoneActivate(d, duoAdminClient, true); err != nil {
+		return err
+	}
 	return resourcePhoneRead(d, meta)
 }
 
+// Phone extends admin.Phone with the attributes the client library doesn't
+// model yet.
+type Phone struct {
+	admin.Phone
+	Model            string `json:"model"`
+	SMSPasscodesSent bool   `json:"sms_passcodes_sent"`
+}
+
+type PhoneResult struct {
+	duoapi.StatResult
+	Response Phone
+}
+
+func getPhone(duoAdminClient *admin.Client, phoneID string) (*PhoneResult, error) {
+	_, body, err := duoAdminClient.SignedCall("GET", fmt.Sprintf("/admin/v1/phones/%s", phoneID), nil, duoapi.UseTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PhoneResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// normalizePhoneNumber converts a phone number to E.164 form, assuming
+// 10 digit numbers without a leading "+" are in the North American
+// numbering plan as Duo does.
+func normalizePhoneNumber(number string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, number)
+	if digits == "" {
+		return ""
+	}
+	if len(digits) == 10 && !strings.HasPrefix(strings.TrimSpace(number), "+") {
+		digits = "1" + digits
+	}
+	return "+" + digits
+}
+
+func suppressEquivalentPhoneNumbers(k, old, new string, d *schema.ResourceData) bool {
+	return normalizePhoneNumber(old) == normalizePhoneNumber(new)
+}
+
 func resourcePhoneRead(d *schema.ResourceData, meta interface{}) error {
 	duoclient := meta.(*duoapi.DuoApi)
 	duoAdminClient := admin.New(*duoclient)
 
 	pid := d.Id()
 
-	result, err := duoAdminClient.GetPhone(pid)
+	result, err := getPhone(duoAdminClient, pid)
 	if err != nil {
 		return err
 	}
@@ -129,17 +344,102 @@ func resourcePhoneRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("could not read phone from duo %s, %s", result.Stat, *result.Message)
 	}
 
-	d.Set("number", result.Response.Number)
-	d.Set("name", result.Response.Name)
-	d.Set("type", result.Response.Type)
-	d.Set("extension", result.Response.Extension)
-	d.Set("platform", result.Response.Platform)
-	d.Set("predelay", result.Response.Predelay)
-	d.Set("postdelay", result.Response.Postdelay)
-	d.Set("phone_id", result.Response.PhoneID)
+	phone := result.Response
+	// Compare the configured number in the same form so formatting alone
+	// isn't attributed as drift
+	number := phone.Number
+	if configured := d.Get("number").(string); normalizePhoneNumber(configured) == normalizePhoneNumber(number) {
+		number = configured
+	}
+	attributeDrift(d, duoclient, map[string]string{
+		"number":    number,
+		"name":      phone.Name,
+		"type":      phone.Type,
+		"extension": phone.Extension,
+		"platform":  phone.Platform,
+		"predelay":  phone.Predelay,
+		"postdelay": phone.Postdelay,
+	}, "phone_", d.Get("number").(string), phone.Number, d.Get("name").(string), phone.Name)
+
+	d.Set("number", phone.Number)
+	d.Set("name", phone.Name)
+	d.Set("type", phone.Type)
+	d.Set("extension", phone.Extension)
+	d.Set("platform", phone.Platform)
+	d.Set("predelay", phone.Predelay)
+	d.Set("postdelay", phone.Postdelay)
+	d.Set("phone_id", phone.PhoneID)
+	d.Set("activated", phone.Activated)
+	d.Set("capabilities", phone.Capabilities)
+	d.Set("encrypted", phone.Encrypted)
+	d.Set("fingerprint", phone.Fingerprint)
+	d.Set("screenlock", phone.Screenlock)
+	d.Set("sms_passcodes_sent", phone.SMSPasscodesSent)
+	d.Set("model", phone.Model)
+	return nil
+}
+
+type PhoneActivation struct {
+	ActivationBarcode string `json:"activation_barcode"`
+	ActivationURL     string `json:"activation_url"`
+	InstallationURL   string `json:"installation_url"`
+	ValidSecs         int    `json:"valid_secs"`
+}
+
+type PhoneActivationResult struct {
+	duoapi.StatResult
+	Response PhoneActivation
+}
+
+// resourcePhoneActivate generates an activation URL and sends an activation
+// SMS when their triggers are set on create, or change afterwards.
+func resourcePhoneActivate(d *schema.ResourceData, duoAdminClient *admin.Client, created bool) error {
+	triggered := func(k string) bool {
+		return d.Get(k).(string) != "" && (created || d.HasChange(k))
+	}
+
+	if triggered("activation_trigger") {
+		activation, err := phoneActivation(duoAdminClient, d, "activation_url")
+		if err != nil {
+			return err
+		}
+		d.Set("activation_url", activation.ActivationURL)
+		d.Set("activation_barcode", activation.ActivationBarcode)
+		d.Set("installation_url", activation.InstallationURL)
+	}
+	if triggered("sms_activation_trigger") {
+		if _, err := phoneActivation(duoAdminClient, d, "send_sms_activation"); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func phoneActivation(duoAdminClient *admin.Client, d *schema.ResourceData, action string) (*PhoneActivation, error) {
+	pid := d.Id()
+	params := url.Values{}
+	params.Set("valid_secs", strconv.Itoa(d.Get("activation_valid_secs").(int)))
+	if d.Get("activation_install").(bool) {
+		params.Set("install", "1")
+	} else {
+		params.Set("install", "0")
+	}
+
+	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/phones/%s/%s", pid, action), params, duoapi.UseTimeout)
+	if err != nil {
+		return nil, err
+	}
+	result := &PhoneActivationResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Stat != "OK" {
+		return nil, fmt.Errorf("could not call %s for phone %s %s: %s", action, pid, result.Stat, *result.Message)
+	}
+	return &result.Response, nil
+}
+
 func resourcePhoneUpdate(d *schema.ResourceData, meta interface{}) error {
 	duoclient := meta.(*duoapi.DuoApi)
 	duoAdminClient := admin.New(*duoclient)
@@ -170,18 +470,23 @@ func resourcePhoneUpdate(d *schema.ResourceData, meta interface{}) error {
 		params.Set("postdelay", d.Get("postdelay").(string))
 	}
 
-	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/phones/%s", pid), params, duoapi.UseTimeout)
-	if err != nil {
-		return err
+	if len(params) > 0 {
+		_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/phones/%s", pid), params, duoapi.UseTimeout)
+		if err != nil {
+			return err
+		}
+		result := &admin.GetPhoneResult{}
+		err = json.Unmarshal(body, result)
+		if err != nil {
+			return err
+		}
+		if result.Stat != "OK" {
+			return fmt.Errorf("there was a problem updating phone %s: %s", pid, *result.Message)
+		}
 	}
-	result := &admin.GetPhoneResult{}
-	err = json.Unmarshal(body, result)
-	if err != nil {
+	if err := resourcePhoneActivate(d, duoAdminClient, false); err != nil {
 		return err
 	}
-	if result.Stat != "OK" {
-		return fmt.Errorf("there was a problem updating phone %s: %s", pid, *result.Message)
-	}
 	d.Partial(false)
 	return resourcePhoneRead(d, meta)
 }
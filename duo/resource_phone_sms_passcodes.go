@@ -0,0 +1,100 @@
+package duo
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourcePhoneSMSPasscodes sends a new batch of SMS passcodes to a phone.
+// The passcodes are sent again whenever the triggers change.
+func resourcePhoneSMSPasscodes() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePhoneSMSPasscodesCreate,
+		Read:   resourcePhoneSMSPasscodesRead,
+		Delete: resourcePhoneSMSPasscodesDelete,
+
+		Schema: map[string]*schema.Schema{
+			"phone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"next_passcode": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+type PhoneSMSPasscodesResult struct {
+	duoapi.StatResult
+	Response json.RawMessage
+}
+
+type PhoneSMSPasscodes struct {
+	NextPasscode string `json:"next_passcode"`
+}
+
+func resourcePhoneSMSPasscodesCreate(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	phoneID := d.Get("phone_id").(string)
+	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/phones/%s/send_sms_passcodes", phoneID), nil, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+	result := &PhoneSMSPasscodesResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("could not send SMS passcodes to phone %s %s: %s", phoneID, result.Stat, *result.Message)
+	}
+
+	d.SetId(phoneID)
+	// Duo usually responds with an empty string, so the hint is only kept
+	// when the response is an object carrying one
+	var passcodes PhoneSMSPasscodes
+	if err := json.Unmarshal(result.Response, &passcodes); err != nil {
+		log.Printf("[DEBUG] no next passcode returned for phone %s", phoneID)
+	}
+	d.Set("next_passcode", passcodes.NextPasscode)
+	return resourcePhoneSMSPasscodesRead(d, meta)
+}
+
+func resourcePhoneSMSPasscodesRead(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	result, err := getPhone(duoAdminClient, d.Id())
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		if *result.Message == "Resource not found" {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("could not read phone from duo %s, %s", result.Stat, *result.Message)
+	}
+	return nil
+}
+
+func resourcePhoneSMSPasscodesDelete(d *schema.ResourceData, meta interface{}) error {
+	// Passcodes can't be recalled once sent
+	return nil
+}
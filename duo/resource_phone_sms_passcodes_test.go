@@ -0,0 +1,85 @@
+package duo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPhoneSMSPasscodes_Basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPhoneDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckPhoneSMSPasscodesConfig(rInt, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"duo_phone_sms_passcodes.test", "phone_id", "duo_phone.test", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckPhoneSMSPasscodesConfig(rInt, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"duo_phone_sms_passcodes.test", "triggers.resend", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourcePhoneSMSPasscodesCreate(t *testing.T) {
+	const pid = "DPBBBBBBBBBBBBBBBBBB"
+	cases := map[string]string{
+		`""`:                          "",
+		`{"next_passcode": "123456"}`: "123456",
+	}
+
+	for response, expected := range cases {
+		client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/admin/v1/phones/" + pid + "/send_sms_passcodes":
+				fmt.Fprint(w, `{"stat": "OK", "response": `+response+`}`)
+			case "/admin/v1/phones/" + pid:
+				fmt.Fprint(w, `{"stat": "OK", "response": {"phone_id": "`+pid+`"}}`)
+			default:
+				t.Errorf("unexpected request to %s", r.URL.Path)
+			}
+		})
+
+		d := resourcePhoneSMSPasscodes().TestResourceData()
+		d.Set("phone_id", pid)
+		err := resourcePhoneSMSPasscodesCreate(d, client)
+		closeServer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual := d.Get("next_passcode").(string); actual != expected {
+			t.Errorf("expected next_passcode %q for response %s, got %q", expected, response, actual)
+		}
+	}
+}
+
+func testAccCheckPhoneSMSPasscodesConfig(rInt int, resend string) string {
+	return fmt.Sprintf(`
+resource "duo_phone" "test" {
+  name = "test-phone-%d"
+  number = "+18005551242"
+  type = "Mobile"
+  platform = "Google Android"
+}
+
+resource "duo_phone_sms_passcodes" "test" {
+  phone_id = "${duo_phone.test.id}"
+  triggers = {
+    resend = "%s"
+  }
+}
+`, rInt, resend)
+}
@@ -2,6 +2,7 @@ package duo
 
 import (
 	"fmt"
+	"net/url"
 	"testing"
 
 	"github.com/duosecurity/duo_api_golang"
@@ -26,6 +27,10 @@ func TestAccPhone_Basic(t *testing.T) {
 						"duo_phone.test", "name", fmt.Sprintf("test-phone-%d", rInt)),
 					resource.TestCheckResourceAttr(
 						"duo_phone.test", "number", "+18005551234"),
+					resource.TestCheckResourceAttr(
+						"duo_phone.test", "activated", "false"),
+					resource.TestCheckResourceAttr(
+						"duo_phone.test", "sms_passcodes_sent", "false"),
 					resource.TestCheckResourceAttr(
 						"duo_phone.test", "type", "Mobile"),
 					resource.TestCheckResourceAttr(
@@ -50,6 +55,122 @@ func TestAccPhone_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPhone_adoptExisting(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPhoneDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				PreConfig: func() {
+					duoclient := testAccProvider.Meta().(*duoapi.DuoApi)
+					duoAdminClient := admin.New(*duoclient)
+					params := url.Values{}
+					params.Set("number", "+18005551236")
+					params.Set("name", "someone else's phone")
+					if _, _, err := duoAdminClient.SignedCall("POST", "/admin/v1/phones", params, duoapi.UseTimeout); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccCheckPhoneConfigAdopt(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPhoneExists("duo_phone.test"),
+					resource.TestCheckResourceAttr(
+						"duo_phone.test", "name", fmt.Sprintf("test-phone-%d", rInt)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPhone_activation(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPhoneDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckPhoneConfigActivation(rInt, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPhoneExists("duo_phone.test"),
+					resource.TestCheckResourceAttrSet(
+						"duo_phone.test", "activation_url"),
+					resource.TestCheckResourceAttrSet(
+						"duo_phone.test", "activation_barcode"),
+					resource.TestCheckResourceAttr(
+						"duo_phone.test", "activated", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckPhoneConfigActivation(rInt, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"duo_phone.test", "activation_url"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPhone_formattedNumber(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPhoneDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckPhoneConfigFormatted(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPhoneExists("duo_phone.test"),
+					resource.TestCheckResourceAttr(
+						"duo_phone.test", "number", "+18005551241"),
+				),
+			},
+			resource.TestStep{
+				Config:             testAccCheckPhoneConfigFormatted(rInt),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	cases := map[string]string{
+		"":                 "",
+		"+18005551234":     "+18005551234",
+		"+1 800-555-1234":  "+18005551234",
+		"(800) 555-1234":   "+18005551234",
+		"8005551234":       "+18005551234",
+		"+44 20 7946 0000": "+442079460000",
+		"+4420712345":      "+4420712345",
+	}
+	for number, expected := range cases {
+		if actual := normalizePhoneNumber(number); actual != expected {
+			t.Errorf("normalizePhoneNumber(%q) = %q; expected %q", number, actual, expected)
+		}
+	}
+}
+
+func TestSuppressEquivalentPhoneNumbers(t *testing.T) {
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{"+18005551234", "+1 800-555-1234", true},
+		{"+18005551234", "8005551234", true},
+		{"+14420712345", "+4420712345", false},
+	}
+	for _, c := range cases {
+		if actual := suppressEquivalentPhoneNumbers("number", c.old, c.new, nil); actual != c.suppress {
+			t.Errorf("suppressEquivalentPhoneNumbers(%q, %q) = %t; expected %t", c.old, c.new, actual, c.suppress)
+		}
+	}
+}
+
 func TestAccPhone_import(t *testing.T) {
 	resourceName := "duo_phone.test"
 	rInt := acctest.RandInt()
@@ -65,6 +186,12 @@ func TestAccPhone_import(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"attribute_drift",
+					"adopt_existing",
+					"activation_install",
+					"activation_valid_secs",
+				},
 			},
 		},
 	})
@@ -139,3 +266,39 @@ resource "duo_phone" "test" {
 }
 `, rInt)
 }
+
+func testAccCheckPhoneConfigFormatted(rInt int) string {
+	return fmt.Sprintf(`
+resource "duo_phone" "test" {
+  name = "test-phone-%d"
+  number = "+1 800-555-1241"
+  type = "Mobile"
+  platform = "Unknown"
+}
+`, rInt)
+}
+
+func testAccCheckPhoneConfigAdopt(rInt int) string {
+	return fmt.Sprintf(`
+resource "duo_phone" "test" {
+  name = "test-phone-%d"
+  number = "+18005551236"
+  type = "Mobile"
+  platform = "Unknown"
+  adopt_existing = true
+}
+`, rInt)
+}
+
+func testAccCheckPhoneConfigActivation(rInt int, trigger string) string {
+	return fmt.Sprintf(`
+resource "duo_phone" "test" {
+  name = "test-phone-%d"
+  number = "+18005551240"
+  type = "Mobile"
+  platform = "Google Android"
+  activation_trigger = "%s"
+  activation_valid_secs = 3600
+}
+`, rInt, trigger)
+}
@@ -3,7 +3,9 @@ package duo
 import (
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/url"
+	"strings"
 
 	"github.com/duosecurity/duo_api_golang"
 	admin "github.com/duosecurity/duo_api_golang/admin"
@@ -18,31 +20,56 @@ func resourceUser() *schema.Resource {
 		Delete: resourceUserDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceUserImport,
 		},
 
-		Schema: map[string]*schema.Schema{
+		CustomizeDiff: resourceUserCustomizeDiff,
+
+		Schema: driftAttributionSchema(map[string]*schema.Schema{
 			"username": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"alias1": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Deprecated:    "Use aliases instead",
+				ConflictsWith: []string{"aliases"},
 			},
 			"alias2": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Deprecated:    "Use aliases instead",
+				ConflictsWith: []string{"aliases"},
 			},
 			"alias3": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Deprecated:    "Use aliases instead",
+				ConflictsWith: []string{"aliases"},
+			},
+			"alias4": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Deprecated:    "Use aliases instead",
+				ConflictsWith: []string{"aliases"},
+			},
+			"aliases": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      maxUserAliases,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"alias1", "alias2", "alias3", "alias4"},
+			},
+			"realname": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"alias4": &schema.Schema{
+			"firstname": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"realname": &schema.Schema{
+			"lastname": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 			},
@@ -59,13 +86,237 @@ func resourceUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"destroy_behavior": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "delete",
+				ValidateFunc: validateStringInSlice([]string{"delete", "disable", "trash_and_purge"}),
+			},
+			"restore_from_trash": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"adopt_existing": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"user_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 			},
-		},
+			"created": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_login": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_directory_sync": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_enrolled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"phones": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"phone_id":  {Type: schema.TypeString, Computed: true},
+						"number":    {Type: schema.TypeString, Computed: true},
+						"name":      {Type: schema.TypeString, Computed: true},
+						"type":      {Type: schema.TypeString, Computed: true},
+						"platform":  {Type: schema.TypeString, Computed: true},
+						"activated": {Type: schema.TypeBool, Computed: true},
+					},
+				},
+			},
+			"tokens": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"token_id": {Type: schema.TypeString, Computed: true},
+						"type":     {Type: schema.TypeString, Computed: true},
+						"serial":   {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+			"u2ftokens": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"registration_id": {Type: schema.TypeString, Computed: true},
+						"date_added":      {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+			"webauthncredentials": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"webauthnkey":     {Type: schema.TypeString, Computed: true},
+						"credential_name": {Type: schema.TypeString, Computed: true},
+						"label":           {Type: schema.TypeString, Computed: true},
+						"date_added":      {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+			"groups": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {Type: schema.TypeString, Computed: true},
+						"name":     {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		}),
+	}
+}
+
+// maxUserAliases is the number of aliases Duo allows a user to have.
+const maxUserAliases = 8
+
+// User extends admin.User with the attributes the client library doesn't
+// model yet.
+type User struct {
+	admin.User
+	Alias5              *string
+	Alias6              *string
+	Alias7              *string
+	Alias8              *string
+	IsEnrolled          bool                 `json:"is_enrolled"`
+	U2FTokens           []admin.U2FToken     `json:"u2ftokens"`
+	WebAuthnCredentials []WebAuthnCredential `json:"webauthncredentials"`
+}
+
+// WebAuthnCredential models a WebAuthn security key or platform
+// authenticator registered to a user.
+type WebAuthnCredential struct {
+	CredentialName string `json:"credential_name"`
+	DateAdded      int64  `json:"date_added"`
+	Label          string `json:"label"`
+	WebAuthnKey    string `json:"webauthnkey"`
+	User           *admin.User
+}
+
+// EnrolledFactors returns the kinds of authentication factors the user has
+// enrolled.
+func (u User) EnrolledFactors() []string {
+	factors := []string{}
+	if len(u.Phones) > 0 {
+		factors = append(factors, "phone")
+	}
+	if len(u.Tokens) > 0 {
+		factors = append(factors, "hardware_token")
+	}
+	if len(u.U2FTokens) > 0 {
+		factors = append(factors, "u2f")
+	}
+	if len(u.WebAuthnCredentials) > 0 {
+		factors = append(factors, "webauthn")
+	}
+	return factors
+}
+
+// Aliases returns the user's aliases in order, alias1 first.
+func (u User) Aliases() []*string {
+	return []*string{u.Alias1, u.Alias2, u.Alias3, u.Alias4, u.Alias5, u.Alias6, u.Alias7, u.Alias8}
+}
+
+type UserResult struct {
+	duoapi.StatResult
+	Response User
+}
+
+func getUser(duoAdminClient *admin.Client, userID string) (*UserResult, error) {
+	_, body, err := duoAdminClient.SignedCall("GET", fmt.Sprintf("/admin/v1/users/%s", userID), nil, duoapi.UseTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UserResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// setUserAliasParams sets alias1 through alias8 from the aliases list,
+// clearing the aliases beyond the end of the list.
+func setUserAliasParams(params url.Values, aliases []interface{}) {
+	for i := 0; i < maxUserAliases; i++ {
+		alias := ""
+		if i < len(aliases) {
+			alias = aliases[i].(string)
+		}
+		params.Set(fmt.Sprintf("alias%d", i+1), alias)
+	}
+}
+
+// resourceUserCustomizeDiff checks that the configured aliases are unique,
+// both within the list and against the usernames and aliases of other
+// users when they're known at plan time.
+func resourceUserCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("aliases") || !d.NewValueKnown("aliases") {
+		return nil
 	}
+
+	seen := map[string]bool{}
+	for _, v := range d.Get("aliases").([]interface{}) {
+		alias := v.(string)
+		if seen[alias] {
+			return fmt.Errorf("alias %q is listed more than once", alias)
+		}
+		seen[alias] = true
+	}
+
+	adopting := ""
+	if d.Get("adopt_existing").(bool) || d.Get("restore_from_trash").(bool) {
+		adopting = d.Get("username").(string)
+	}
+
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+	for alias := range seen {
+		result, err := duoAdminClient.GetUsers(admin.GetUsersUsername(alias))
+		if err != nil {
+			log.Printf("[WARN] could not check alias %q is unique: %s", alias, err)
+			continue
+		}
+		if result.Stat != "OK" {
+			log.Printf("[WARN] could not check alias %q is unique: %s", alias, *result.Message)
+			continue
+		}
+		if u := aliasConflict(result.Response, d.Id(), adopting); u != nil {
+			return fmt.Errorf("alias %q is already used by user %s (%s)", alias, u.Username, u.UserID)
+		}
+	}
+	return nil
+}
+
+// aliasConflict returns the first of users holding an alias that isn't the
+// user being managed. A user that's about to be adopted or restored has no
+// ID in state yet, so it's recognised by its username instead.
+func aliasConflict(users []admin.User, userID, adopting string) *admin.User {
+	for i, u := range users {
+		if u.UserID == userID || (adopting != "" && u.Username == adopting) {
+			continue
+		}
+		return &users[i]
+	}
+	return nil
 }
 
 func resourceUserCreate(d *schema.ResourceData, meta interface{}) error {
@@ -75,6 +326,9 @@ func resourceUserCreate(d *schema.ResourceData, meta interface{}) error {
 	params := url.Values{}
 	params.Set("username", d.Get("username").(string))
 
+	if aliases := d.Get("aliases").([]interface{}); len(aliases) > 0 {
+		setUserAliasParams(params, aliases)
+	}
 	if d.Get("alias1") != "" {
 		params.Set("alias1", d.Get("alias1").(string))
 	}
@@ -88,10 +342,31 @@ func resourceUserCreate(d *schema.ResourceData, meta interface{}) error {
 		params.Set("alias4", d.Get("alias4").(string))
 	}
 	params.Set("realname", d.Get("realname").(string))
+	params.Set("firstname", d.Get("firstname").(string))
+	params.Set("lastname", d.Get("lastname").(string))
 	params.Set("email", d.Get("email").(string))
 	params.Set("status", d.Get("status").(string))
 	params.Set("notes", d.Get("notes").(string))
 
+	username := d.Get("username").(string)
+	if d.Get("adopt_existing").(bool) || d.Get("restore_from_trash").(bool) {
+		existing, err := findUserByUsername(duoAdminClient, username)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			if existing.Status == "pending deletion" {
+				if !d.Get("restore_from_trash").(bool) {
+					return fmt.Errorf("user %s (%s) is pending deletion in the trash and can't be adopted; set restore_from_trash to restore it", username, existing.UserID)
+				}
+				return resourceUserRestore(d, meta, existing, params)
+			} else if d.Get("adopt_existing").(bool) {
+				log.Printf("[INFO] adopting existing user %s (%s)", existing.Username, existing.UserID)
+				return resourceUserReconcile(d, meta, existing.UserID, params)
+			}
+		}
+	}
+
 	_, body, err := duoAdminClient.SignedCall("POST", "/admin/v1/users", params, duoapi.UseTimeout)
 	if err != nil {
 		return err
@@ -102,6 +377,9 @@ func resourceUserCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 	if result.Stat != "OK" {
+		if existing, _ := findUserByUsername(duoAdminClient, username); existing != nil {
+			return fmt.Errorf("could not create user %s, it already exists as %s (status %s); set adopt_existing or import it", username, existing.UserID, existing.Status)
+		}
 		return fmt.Errorf("could not create user %s %s", result.Stat, *result.Message)
 	}
 
@@ -110,13 +388,102 @@ func resourceUserCreate(d *schema.ResourceData, meta interface{}) error {
 	return resourceUserRead(d, meta)
 }
 
+// findUserByUsername returns the user with username, or nil if there isn't
+// one.
+func findUserByUsername(duoAdminClient *admin.Client, username string) (*admin.User, error) {
+	result, err := duoAdminClient.GetUsers(admin.GetUsersUsername(username))
+	if err != nil {
+		return nil, err
+	}
+	if result.Stat != "OK" {
+		return nil, fmt.Errorf("could not look up user %s %s: %s", username, result.Stat, *result.Message)
+	}
+	for _, u := range result.Response {
+		if u.Username == username {
+			return &u, nil
+		}
+	}
+	return nil, nil
+}
+
+// resourceUserImport accepts either a user ID or username:<name>.
+func resourceUserImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !strings.HasPrefix(d.Id(), "username:") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	username := strings.TrimPrefix(d.Id(), "username:")
+	user, err := findUserByUsername(duoAdminClient, username)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, fmt.Errorf("could not find a user named %q", username)
+	}
+	d.SetId(user.UserID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceUserRestore brings a user back out of the trash and applies the
+// configured attributes to it, instead of creating a new user.
+func resourceUserRestore(d *schema.ResourceData, meta interface{}, user *admin.User, params url.Values) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	userIDs, err := json.Marshal([]string{user.UserID})
+	if err != nil {
+		return err
+	}
+	restoreParams := url.Values{}
+	restoreParams.Set("user_id_list", string(userIDs))
+	_, body, err := duoAdminClient.SignedCall("POST", "/admin/v1/users/bulk_restore", restoreParams, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+	var restoreResult deleteResult
+	err = json.Unmarshal(body, &restoreResult)
+	if err != nil {
+		return err
+	}
+	if restoreResult.Stat != "OK" {
+		return fmt.Errorf("could not restore user %s from the trash %s: %s", user.UserID, restoreResult.Stat, *restoreResult.Message)
+	}
+	log.Printf("[INFO] restored user %s (%s) from the trash", user.Username, user.UserID)
+	return resourceUserReconcile(d, meta, user.UserID, params)
+}
+
+// resourceUserReconcile takes ownership of an existing user and applies the
+// configured attributes to it.
+func resourceUserReconcile(d *schema.ResourceData, meta interface{}, userID string, params url.Values) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	d.SetId(userID)
+	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/users/%s", userID), params, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+	result := &admin.GetUserResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("there was a problem updating existing user %s: %s", userID, *result.Message)
+	}
+	return resourceUserRead(d, meta)
+}
+
 func resourceUserRead(d *schema.ResourceData, meta interface{}) error {
 	duoclient := meta.(*duoapi.DuoApi)
 	duoAdminClient := admin.New(*duoclient)
 
 	uid := d.Id()
 
-	result, err := duoAdminClient.GetUser(uid)
+	result, err := getUser(duoAdminClient, uid)
 	if err != nil {
 		return err
 	}
@@ -129,19 +496,147 @@ func resourceUserRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("could not read user from duo %s, %s", result.Stat, *result.Message)
 	}
 	user := result.Response
+
+	// Aliases are tracked as a list once the configuration uses one, or
+	// when the user has more aliases than the legacy fields can hold
+	var aliases []string
+	for _, alias := range user.Aliases() {
+		if stringValue(alias) != "" {
+			aliases = append(aliases, *alias)
+		}
+	}
+	useAliasList := len(d.Get("aliases").([]interface{})) > 0
+	if !useAliasList && d.Get("alias1").(string) == "" && d.Get("alias2").(string) == "" &&
+		d.Get("alias3").(string) == "" && d.Get("alias4").(string) == "" {
+		for _, alias := range user.Aliases()[4:] {
+			if stringValue(alias) != "" {
+				useAliasList = true
+			}
+		}
+	}
+
+	remote := map[string]string{
+		"username":  user.Username,
+		"realname":  stringValue(user.RealName),
+		"firstname": stringValue(user.FirstName),
+		"lastname":  stringValue(user.LastName),
+		"email":     user.Email,
+		"status":    user.Status,
+		"notes":     user.Notes,
+	}
+	if !useAliasList {
+		remote["alias1"] = stringValue(user.Alias1)
+		remote["alias2"] = stringValue(user.Alias2)
+		remote["alias3"] = stringValue(user.Alias3)
+		remote["alias4"] = stringValue(user.Alias4)
+	}
+	attributeDrift(d, duoclient, remote, "user_", d.Get("username").(string), user.Username)
+
 	d.Set("username", user.Username)
-	d.Set("alias1", user.Alias1)
-	d.Set("alias2", user.Alias2)
-	d.Set("alias3", user.Alias3)
-	d.Set("alias4", user.Alias4)
+	if useAliasList {
+		d.Set("aliases", aliases)
+		d.Set("alias1", "")
+		d.Set("alias2", "")
+		d.Set("alias3", "")
+		d.Set("alias4", "")
+	} else {
+		d.Set("aliases", nil)
+		d.Set("alias1", user.Alias1)
+		d.Set("alias2", user.Alias2)
+		d.Set("alias3", user.Alias3)
+		d.Set("alias4", user.Alias4)
+	}
 	d.Set("realname", user.RealName)
+	d.Set("firstname", user.FirstName)
+	d.Set("lastname", user.LastName)
 	d.Set("email", user.Email)
 	d.Set("status", user.Status)
 	d.Set("notes", user.Notes)
 	d.Set("user_id", user.UserID)
+	d.Set("created", secondsToTime(int64(user.Created)))
+	d.Set("last_login", optionalSecondsToTime(user.LastLogin))
+	d.Set("last_directory_sync", optionalSecondsToTime(user.LastDirectorySync))
+	d.Set("is_enrolled", user.IsEnrolled)
+	if err := d.Set("phones", flattenUserPhones(user.Phones)); err != nil {
+		return err
+	}
+	if err := d.Set("tokens", flattenUserTokens(user.Tokens)); err != nil {
+		return err
+	}
+	if err := d.Set("u2ftokens", flattenU2FTokens(user.U2FTokens)); err != nil {
+		return err
+	}
+	if err := d.Set("webauthncredentials", flattenWebAuthnCredentials(user.WebAuthnCredentials)); err != nil {
+		return err
+	}
+	if err := d.Set("groups", flattenUserGroups(user.Groups)); err != nil {
+		return err
+	}
 	return nil
 }
 
+func flattenUserPhones(phones []admin.Phone) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(phones))
+	for _, p := range phones {
+		flattened = append(flattened, map[string]interface{}{
+			"phone_id":  p.PhoneID,
+			"number":    p.Number,
+			"name":      p.Name,
+			"type":      p.Type,
+			"platform":  p.Platform,
+			"activated": p.Activated,
+		})
+	}
+	return flattened
+}
+
+func flattenUserTokens(tokens []admin.Token) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(tokens))
+	for _, t := range tokens {
+		flattened = append(flattened, map[string]interface{}{
+			"token_id": t.TokenID,
+			"type":     t.Type,
+			"serial":   t.Serial,
+		})
+	}
+	return flattened
+}
+
+func flattenU2FTokens(tokens []admin.U2FToken) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(tokens))
+	for _, t := range tokens {
+		flattened = append(flattened, map[string]interface{}{
+			"registration_id": t.RegistrationID,
+			"date_added":      secondsToTime(int64(t.DateAdded)),
+		})
+	}
+	return flattened
+}
+
+func flattenWebAuthnCredentials(credentials []WebAuthnCredential) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(credentials))
+	for _, c := range credentials {
+		flattened = append(flattened, map[string]interface{}{
+			"webauthnkey":     c.WebAuthnKey,
+			"credential_name": c.CredentialName,
+			"label":           c.Label,
+			"date_added":      secondsToTime(c.DateAdded),
+		})
+	}
+	return flattened
+}
+
+func flattenUserGroups(groups []admin.Group) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(groups))
+	for _, g := range groups {
+		flattened = append(flattened, map[string]interface{}{
+			"group_id": g.GroupID,
+			"name":     g.Name,
+		})
+	}
+	return flattened
+}
+
 func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
 	duoclient := meta.(*duoapi.DuoApi)
 	duoAdminClient := admin.New(*duoclient)
@@ -153,21 +648,32 @@ func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("username") {
 		params.Set("username", d.Get("username").(string))
 	}
-	if d.HasChange("alias1") {
-		params.Set("alias1", d.Get("alias1").(string))
-	}
-	if d.HasChange("alias2") {
-		params.Set("alias2", d.Get("alias2").(string))
+	if d.HasChange("aliases") {
+		setUserAliasParams(params, d.Get("aliases").([]interface{}))
 	}
-	if d.HasChange("alias3") {
-		params.Set("alias3", d.Get("alias3").(string))
-	}
-	if d.HasChange("alias4") {
-		params.Set("alias4", d.Get("alias4").(string))
+	if len(d.Get("aliases").([]interface{})) == 0 {
+		if d.HasChange("alias1") {
+			params.Set("alias1", d.Get("alias1").(string))
+		}
+		if d.HasChange("alias2") {
+			params.Set("alias2", d.Get("alias2").(string))
+		}
+		if d.HasChange("alias3") {
+			params.Set("alias3", d.Get("alias3").(string))
+		}
+		if d.HasChange("alias4") {
+			params.Set("alias4", d.Get("alias4").(string))
+		}
 	}
 	if d.HasChange("realname") {
 		params.Set("realname", d.Get("realname").(string))
 	}
+	if d.HasChange("firstname") {
+		params.Set("firstname", d.Get("firstname").(string))
+	}
+	if d.HasChange("lastname") {
+		params.Set("lastname", d.Get("lastname").(string))
+	}
 	if d.HasChange("email") {
 		params.Set("email", d.Get("email").(string))
 	}
@@ -198,6 +704,12 @@ func resourceUserDelete(d *schema.ResourceData, meta interface{}) error {
 	duoAdminClient := admin.New(*duoclient)
 
 	userID := d.Id()
+	if d.Get("destroy_behavior").(string) == "disable" {
+		params := url.Values{}
+		params.Set("status", "disabled")
+		return updateUserForDelete(duoAdminClient, userID, params)
+	}
+
 	_, body, err := duoAdminClient.SignedCall("DELETE", fmt.Sprintf("/admin/v1/users/%s", userID), nil, duoapi.UseTimeout)
 	if err != nil {
 		return err
@@ -211,5 +723,43 @@ func resourceUserDelete(d *schema.ResourceData, meta interface{}) error {
 	if result.Stat != "OK" {
 		return fmt.Errorf("there was a problem deleting user %s: %s", userID, *result.Message)
 	}
+
+	if d.Get("destroy_behavior").(string) == "trash_and_purge" {
+		return purgeDeletedUser(duoAdminClient, userID, d.Get("username").(string))
+	}
+	return nil
+}
+
+// purgeDeletedUser makes sure a deleted user hasn't been left in the trash
+// holding on to its username. The Admin API has no endpoint for emptying
+// the trash, so a user that's still pending deletion has to be purged from
+// the Admin Panel before the username can be reused.
+func purgeDeletedUser(duoAdminClient *admin.Client, userID, username string) error {
+	existing, err := findUserByUsername(duoAdminClient, username)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.UserID == userID {
+		return fmt.Errorf("user %s (%s) was deleted but is still in the trash (status %s); the Admin API can't purge it, so purge it from the Admin Panel before reusing the username", username, userID, existing.Status)
+	}
+	return nil
+}
+
+func updateUserForDelete(duoAdminClient *admin.Client, userID string, params url.Values) error {
+	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/users/%s", userID), params, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+	result := &admin.GetUserResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		if *result.Message == "Resource not found" {
+			return nil
+		}
+		return fmt.Errorf("there was a problem updating user %s before deleting it: %s", userID, *result.Message)
+	}
 	return nil
 }
@@ -0,0 +1,138 @@
+package duo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceUserEnrollment sends a user an enrollment email. The email is
+// sent again whenever the triggers change.
+func resourceUserEnrollment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUserEnrollmentCreate,
+		Read:   resourceUserEnrollmentRead,
+		Delete: resourceUserEnrollmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"valid_secs": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2592000,
+				ForceNew:     true,
+				ValidateFunc: validatePositiveInt,
+			},
+			"triggers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enrollment_code": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"is_enrolled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"enrolled_factors": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+type UserEnrollmentResult struct {
+	duoapi.StatResult
+	Response string
+}
+
+func resourceUserEnrollmentCreate(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	userID := d.Get("user_id").(string)
+	user, err := getUser(duoAdminClient, userID)
+	if err != nil {
+		return err
+	}
+	if user.Stat != "OK" {
+		return fmt.Errorf("could not find user %s to enroll %s: %s", userID, user.Stat, *user.Message)
+	}
+
+	email := d.Get("email").(string)
+	if email == "" {
+		email = user.Response.Email
+	}
+	if email == "" {
+		return fmt.Errorf("user %s has no email address to send an enrollment to, set email", userID)
+	}
+
+	params := url.Values{}
+	params.Set("username", user.Response.Username)
+	params.Set("email", email)
+	params.Set("valid_secs", strconv.Itoa(d.Get("valid_secs").(int)))
+
+	_, body, err := duoAdminClient.SignedCall("POST", "/admin/v1/users/enroll", params, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+	result := &UserEnrollmentResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("could not send enrollment to user %s %s: %s", userID, result.Stat, *result.Message)
+	}
+
+	d.SetId(userID)
+	d.Set("email", email)
+	d.Set("enrollment_code", result.Response)
+	return resourceUserEnrollmentRead(d, meta)
+}
+
+func resourceUserEnrollmentRead(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	result, err := getUser(duoAdminClient, d.Id())
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		if *result.Message == "Resource not found" {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("could not read user from duo %s, %s", result.Stat, *result.Message)
+	}
+
+	d.Set("is_enrolled", result.Response.IsEnrolled)
+	d.Set("enrolled_factors", result.Response.EnrolledFactors())
+	return nil
+}
+
+func resourceUserEnrollmentDelete(d *schema.ResourceData, meta interface{}) error {
+	// An enrollment email can't be recalled once sent
+	return nil
+}
@@ -0,0 +1,74 @@
+package duo
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUserEnrollment_Basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckUserEnrollmentConfig(rInt, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists("duo_user.test"),
+					resource.TestCheckResourceAttr(
+						"duo_user_enrollment.test", "email", "le1f@wut.what"),
+					resource.TestCheckResourceAttr(
+						"duo_user_enrollment.test", "is_enrolled", "false"),
+					resource.TestCheckResourceAttr(
+						"duo_user_enrollment.test", "enrolled_factors.#", "0"),
+					resource.TestCheckResourceAttrSet(
+						"duo_user_enrollment.test", "enrollment_code"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckUserEnrollmentConfig(rInt, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"duo_user_enrollment.test", "triggers.resend", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestUserEnrolledFactors(t *testing.T) {
+	user := User{
+		User: admin.User{
+			Phones: []admin.Phone{admin.Phone{PhoneID: "DPFZRS9FB0D46QFTM891"}},
+		},
+		WebAuthnCredentials: []WebAuthnCredential{WebAuthnCredential{WebAuthnKey: "WABFEOF1R6XDGEWVAOZ2"}},
+	}
+
+	expected := []string{"phone", "webauthn"}
+	if actual := user.EnrolledFactors(); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+}
+
+func testAccCheckUserEnrollmentConfig(rInt int, resend string) string {
+	return fmt.Sprintf(`
+resource "duo_user" "test" {
+  username = "test-user-%d"
+  email = "le1f@wut.what"
+}
+
+resource "duo_user_enrollment" "test" {
+  user_id = "${duo_user.test.id}"
+  valid_secs = 86400
+  triggers = {
+    resend = "%s"
+  }
+}
+`, rInt, resend)
+}
@@ -1,13 +1,15 @@
 package duo
 
 import (
-	"encoding/json"
 	"fmt"
-	"net/url"
+	"log"
+	"regexp"
+	"strings"
 
 	"github.com/duosecurity/duo_api_golang"
 	admin "github.com/duosecurity/duo_api_golang/admin"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func resourceUserPhoneAssociation() *schema.Resource {
@@ -16,6 +18,13 @@ func resourceUserPhoneAssociation() *schema.Resource {
 		Read:   resourceUserPhoneAssociationRead,
 		Delete: resourceUserPhoneAssociationDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceUserPhoneAssociationImport,
+		},
+
+		SchemaVersion: 1,
+		MigrateState:  resourceUserPhoneAssociationMigrateState,
+
 		Schema: map[string]*schema.Schema{
 			"user_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -42,24 +51,10 @@ func resourceUserPhoneAssociationCreate(d *schema.ResourceData, meta interface{}
 	pid := d.Get("phone_id").(string)
 	uid := d.Get("user_id").(string)
 
-	params := url.Values{}
-
-	params.Set("phone_id", pid)
-
-	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/users/%s/phones", uid), params, duoapi.UseTimeout)
-	if err != nil {
-		return err
-	}
-
-	result := &AssociationResult{}
-	err = json.Unmarshal(body, result)
-	if err != nil {
+	if err := attachUserPhone(duoAdminClient, uid, pid); err != nil {
 		return err
 	}
-	if result.Stat != "OK" {
-		return fmt.Errorf("could not associate phone to user %s %s", result.Stat, *result.Message)
-	}
-	d.SetId(fmt.Sprintf("%s-%s", d.Get("user_id").(string), d.Get("phone_id").(string)))
+	d.SetId(fmt.Sprintf("%s:%s", uid, pid))
 	return resourceUserPhoneAssociationRead(d, meta)
 }
 
@@ -68,48 +63,108 @@ func resourceUserPhoneAssociationRead(d *schema.ResourceData, meta interface{})
 	duoAdminClient := admin.New(*duoclient)
 	pid := d.Get("phone_id").(string)
 	uid := d.Get("user_id").(string)
-	result, err := duoAdminClient.GetPhone(pid)
+
+	userResult, err := duoAdminClient.GetUser(uid)
 	if err != nil {
 		return err
 	}
-	if result.Stat != "OK" {
-		return fmt.Errorf(fmt.Sprintf("could not find phone %s", pid))
+	if userResult.Stat != "OK" {
+		if *userResult.Message == "Resource not found" {
+			log.Printf("[WARN] user %s no longer exists, removing phone association %s from state", uid, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("could not read user %s %s, %s", uid, userResult.Stat, *userResult.Message)
+	}
+
+	phonesResult, err := duoAdminClient.GetUserPhones(uid)
+	if err != nil {
+		return err
+	}
+	if phonesResult.Stat != "OK" {
+		return fmt.Errorf("could not read phones of user %s %s, %s", uid, phonesResult.Stat, *phonesResult.Message)
 	}
 
 	var found bool
-	var foundUser string
-	for _, v := range result.Response.Users {
-		if v.UserID == d.Get("user_id").(string) {
+	for _, p := range phonesResult.Response {
+		if p.PhoneID == pid {
 			found = true
-			foundUser = v.UserID
 		}
 	}
 	if !found {
-		return fmt.Errorf("could not find phone %s attached to user %s", pid, uid)
+		log.Printf("[WARN] phone %s is no longer attached to user %s, removing phone association %s from state", pid, uid, d.Id())
+		d.SetId("")
+		return nil
 	}
 	d.Set("phone_id", pid)
-	d.Set("user_id", foundUser)
+	d.Set("user_id", uid)
 	return nil
 }
 
+var (
+	duoUserIDPattern  = regexp.MustCompile(`^DU[0-9A-Z]{18}$`)
+	duoPhoneIDPattern = regexp.MustCompile(`^DP[0-9A-Z]{18}$`)
+)
+
+// resourceUserPhoneAssociationImport accepts either user_id:phone_id or
+// username:number[/ext], resolving the latter through the list APIs.
+func resourceUserPhoneAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	i := strings.LastIndex(d.Id(), ":")
+	if i <= 0 || i == len(d.Id())-1 {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected user_id:phone_id or username:number", d.Id())
+	}
+	uid, pid := d.Id()[:i], d.Id()[i+1:]
+
+	if !duoUserIDPattern.MatchString(uid) || !duoPhoneIDPattern.MatchString(pid) {
+		duoclient := meta.(*duoapi.DuoApi)
+		duoAdminClient := admin.New(*duoclient)
+
+		user, err := findUserByUsername(duoAdminClient, uid)
+		if err != nil {
+			return nil, err
+		}
+		if user == nil {
+			return nil, fmt.Errorf("could not find a user named %q", uid)
+		}
+
+		number, extension := splitPhoneNumber(pid)
+		phone, err := findPhoneByNumber(duoAdminClient, number, extension)
+		if err != nil {
+			return nil, err
+		}
+		if phone == nil {
+			return nil, fmt.Errorf("could not find a phone with number %q and extension %q", number, extension)
+		}
+		uid, pid = user.UserID, phone.PhoneID
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", uid, pid))
+	d.Set("user_id", uid)
+	d.Set("phone_id", pid)
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceUserPhoneAssociationMigrateState moves version 0 states from the
+// user_id-phone_id ID to user_id:phone_id.
+func resourceUserPhoneAssociationMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found duo_user_phone_association state v0; migrating to v1")
+		if is.Empty() {
+			return is, nil
+		}
+		is.ID = fmt.Sprintf("%s:%s", is.Attributes["user_id"], is.Attributes["phone_id"])
+		return is, nil
+	default:
+		return is, fmt.Errorf("unexpected schema version: %d", v)
+	}
+}
+
 func resourceUserPhoneAssociationDelete(d *schema.ResourceData, meta interface{}) error {
 	duoclient := meta.(*duoapi.DuoApi)
 	duoAdminClient := admin.New(*duoclient)
 
 	pid := d.Get("phone_id").(string)
 	uid := d.Get("user_id").(string)
-	_, body, err := duoAdminClient.SignedCall("DELETE", fmt.Sprintf("/admin/v1/users/%s/phones/%s", uid, pid), nil, duoapi.UseTimeout)
-	if err != nil {
-		return err
-	}
-
-	result := &AssociationResult{}
-	err = json.Unmarshal(body, result)
-	if err != nil {
-		return err
-	}
-	if result.Stat != "OK" {
-		return fmt.Errorf("could not disassociate phone %s from user %s: %+v", pid, uid, *result.Message)
-	}
-	return nil
+	return detachUserPhone(duoAdminClient, uid, pid)
 }
@@ -0,0 +1,182 @@
+package duo
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccUserPhoneAssociation_Basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUserPhoneAssociationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckUserPhoneAssociationConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"duo_user_phone_association.test", "user_id", "duo_user.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"duo_user_phone_association.test", "phone_id", "duo_phone.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserPhoneAssociation_import(t *testing.T) {
+	resourceName := "duo_user_phone_association.test"
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUserPhoneAssociationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckUserPhoneAssociationConfig(rInt),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("test-user-%d:+18005551237", rInt),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceUserPhoneAssociationMigrateState(t *testing.T) {
+	is := &terraform.InstanceState{
+		ID: "DUAAAAAAAAAAAAAAAAAA-DPBBBBBBBBBBBBBBBBBB",
+		Attributes: map[string]string{
+			"user_id":  "DUAAAAAAAAAAAAAAAAAA",
+			"phone_id": "DPBBBBBBBBBBBBBBBBBB",
+		},
+	}
+	is, err := resourceUserPhoneAssociationMigrateState(0, is, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if is.ID != "DUAAAAAAAAAAAAAAAAAA:DPBBBBBBBBBBBBBBBBBB" {
+		t.Fatalf("unexpected ID after migration: %s", is.ID)
+	}
+}
+
+func testAccCheckUserPhoneAssociationDestroy(s *terraform.State) error {
+	duoclient := testAccProvider.Meta().(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "duo_user_phone_association" {
+			continue
+		}
+
+		result, err := duoAdminClient.GetUserPhones(r.Primary.Attributes["user_id"])
+		if err != nil {
+			return err
+		}
+		if result.Stat != "OK" {
+			continue
+		}
+		for _, p := range result.Response {
+			if p.PhoneID == r.Primary.Attributes["phone_id"] {
+				return fmt.Errorf("Found phone %s still attached to user %s", p.PhoneID, r.Primary.Attributes["user_id"])
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckUserPhoneAssociationConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "duo_user" "test" {
+  username = "test-user-%[1]d"
+}
+
+resource "duo_phone" "test" {
+  name = "test-phone-%[1]d"
+  number = "+18005551237"
+  type = "Mobile"
+  platform = "Unknown"
+}
+
+resource "duo_user_phone_association" "test" {
+  user_id = "${duo_user.test.id}"
+  phone_id = "${duo_phone.test.id}"
+}
+`, rInt)
+}
+
+func TestResourceUserPhoneAssociationRead(t *testing.T) {
+	const (
+		uid = "DUAAAAAAAAAAAAAAAAAA"
+		pid = "DPBBBBBBBBBBBBBBBBBB"
+	)
+	notFound := `{"stat": "FAIL", "code": 40401, "message": "Resource not found"}`
+	user := `{"stat": "OK", "response": {"user_id": "` + uid + `", "username": "test"}}`
+	// The phones are returned over two pages to exercise pagination.
+	phonesPage := func(r *http.Request, ids ...string) string {
+		if r.URL.Query().Get("offset") == "0" {
+			return `{"stat": "OK", "response": [{"phone_id": "DPCCCCCCCCCCCCCCCCCC"}], "metadata": {"next_offset": 1}}`
+		}
+		var phones []string
+		for _, id := range ids {
+			phones = append(phones, `{"phone_id": "`+id+`"}`)
+		}
+		return `{"stat": "OK", "response": [` + strings.Join(phones, ",") + `], "metadata": {}}`
+	}
+
+	cases := []struct {
+		name     string
+		user     string
+		phones   []string
+		expected bool
+	}{
+		{"present", user, []string{pid}, true},
+		{"user deleted", notFound, nil, false},
+		{"phone deleted", user, nil, false},
+		{"link removed", user, []string{"DPDDDDDDDDDDDDDDDDDD"}, false},
+	}
+
+	for _, c := range cases {
+		client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/admin/v1/users/" + uid:
+				fmt.Fprint(w, c.user)
+			case "/admin/v1/users/" + uid + "/phones":
+				fmt.Fprint(w, phonesPage(r, c.phones...))
+			default:
+				t.Errorf("%s: unexpected request to %s", c.name, r.URL.Path)
+				fmt.Fprint(w, notFound)
+			}
+		})
+
+		d := resourceUserPhoneAssociation().TestResourceData()
+		d.SetId(uid + ":" + pid)
+		d.Set("user_id", uid)
+		d.Set("phone_id", pid)
+
+		err := resourceUserPhoneAssociationRead(d, client)
+		closeServer()
+		if err != nil {
+			t.Fatalf("%s: %s", c.name, err)
+		}
+		if found := d.Id() != ""; found != c.expected {
+			t.Errorf("%s: expected found to be %t, got %t", c.name, c.expected, found)
+		}
+	}
+}
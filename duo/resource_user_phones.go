@@ -0,0 +1,161 @@
+package duo
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceUserPhones authoritatively manages the phones attached to a user,
+// detaching any that aren't listed.
+func resourceUserPhones() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUserPhonesCreate,
+		Read:   resourceUserPhonesRead,
+		Update: resourceUserPhonesUpdate,
+		Delete: resourceUserPhonesDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"phone_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceUserPhonesCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("user_id").(string))
+	return resourceUserPhonesUpdate(d, meta)
+}
+
+func resourceUserPhonesRead(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	uid := d.Id()
+	result, err := duoAdminClient.GetUserPhones(uid)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		if *result.Message == "Resource not found" {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("could not read phones of user %s %s, %s", uid, result.Stat, *result.Message)
+	}
+
+	var phoneIDs []string
+	for _, p := range result.Response {
+		phoneIDs = append(phoneIDs, p.PhoneID)
+	}
+	if _, extra := stringSetDiff(expandStringSet(d.Get("phone_ids").(*schema.Set)), phoneIDs); len(extra) > 0 {
+		log.Printf("[WARN] user %s has unmanaged phones attached: %v", uid, extra)
+	}
+
+	d.Set("user_id", uid)
+	d.Set("phone_ids", phoneIDs)
+	return nil
+}
+
+func resourceUserPhonesUpdate(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	uid := d.Id()
+	result, err := duoAdminClient.GetUserPhones(uid)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("could not read phones of user %s %s, %s", uid, result.Stat, *result.Message)
+	}
+
+	var current []string
+	for _, p := range result.Response {
+		current = append(current, p.PhoneID)
+	}
+	add, remove := stringSetDiff(current, expandStringSet(d.Get("phone_ids").(*schema.Set)))
+	for _, pid := range add {
+		if err := attachUserPhone(duoAdminClient, uid, pid); err != nil {
+			return err
+		}
+	}
+	for _, pid := range remove {
+		if err := detachUserPhone(duoAdminClient, uid, pid); err != nil {
+			return err
+		}
+	}
+	return resourceUserPhonesRead(d, meta)
+}
+
+func resourceUserPhonesDelete(d *schema.ResourceData, meta interface{}) error {
+	duoclient := meta.(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	uid := d.Id()
+	for _, pid := range expandStringSet(d.Get("phone_ids").(*schema.Set)) {
+		if err := detachUserPhone(duoAdminClient, uid, pid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func attachUserPhone(duoAdminClient *admin.Client, uid, pid string) error {
+	params := url.Values{}
+	params.Set("phone_id", pid)
+	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/users/%s/phones", uid), params, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+
+	result := &AssociationResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		return fmt.Errorf("could not associate phone %s to user %s %s: %s", pid, uid, result.Stat, *result.Message)
+	}
+	return nil
+}
+
+// detachUserPhone removes a phone from a user. A phone that's already been
+// detached or deleted is treated as removed.
+func detachUserPhone(duoAdminClient *admin.Client, uid, pid string) error {
+	_, body, err := duoAdminClient.SignedCall("DELETE", fmt.Sprintf("/admin/v1/users/%s/phones/%s", uid, pid), nil, duoapi.UseTimeout)
+	if err != nil {
+		return err
+	}
+
+	result := &AssociationResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return err
+	}
+	if result.Stat != "OK" {
+		if *result.Message == "Resource not found" {
+			return nil
+		}
+		return fmt.Errorf("could not disassociate phone %s from user %s: %s", pid, uid, *result.Message)
+	}
+	return nil
+}
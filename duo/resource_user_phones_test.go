@@ -0,0 +1,105 @@
+package duo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/duosecurity/duo_api_golang"
+	admin "github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccUserPhones_Basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUserPhonesDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckUserPhonesConfig(rInt, `"${duo_phone.one.id}", "${duo_phone.two.id}"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"duo_user_phones.test", "phone_ids.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckUserPhonesConfig(rInt, `"${duo_phone.two.id}"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"duo_user_phones.test", "phone_ids.#", "1"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "duo_user_phones.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceUserPhonesDelete_notFound(t *testing.T) {
+	client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"stat": "FAIL", "code": 40401, "message": "Resource not found"}`)
+	})
+	defer closeServer()
+
+	d := resourceUserPhones().TestResourceData()
+	d.SetId("DU1")
+	d.Set("phone_ids", []string{"DP1", "DP2"})
+
+	if err := resourceUserPhonesDelete(d, client); err != nil {
+		t.Fatalf("expected detached phones to be ignored, got %v", err)
+	}
+}
+
+func testAccCheckUserPhonesDestroy(s *terraform.State) error {
+	duoclient := testAccProvider.Meta().(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "duo_user_phones" {
+			continue
+		}
+
+		result, err := duoAdminClient.GetUserPhones(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if result.Stat == "OK" && len(result.Response) > 0 {
+			return fmt.Errorf("Found phones still attached to user %s: %+v", r.Primary.ID, result.Response)
+		}
+	}
+	return nil
+}
+
+func testAccCheckUserPhonesConfig(rInt int, phoneIDs string) string {
+	return fmt.Sprintf(`
+resource "duo_user" "test" {
+  username = "test-user-%[1]d"
+}
+
+resource "duo_phone" "one" {
+  name = "test-phone-one-%[1]d"
+  number = "+18005551238"
+  type = "Mobile"
+  platform = "Unknown"
+}
+
+resource "duo_phone" "two" {
+  name = "test-phone-two-%[1]d"
+  number = "+18005551239"
+  type = "Mobile"
+  platform = "Unknown"
+}
+
+resource "duo_user_phones" "test" {
+  user_id = "${duo_user.test.id}"
+  phone_ids = [`+phoneIDs+`]
+}
+`, rInt)
+}
@@ -2,6 +2,10 @@ package duo
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/duosecurity/duo_api_golang"
@@ -38,6 +42,18 @@ func TestAccUser_Basic(t *testing.T) {
 						"duo_user.test", "email", "le1f@wut.what"),
 					resource.TestCheckResourceAttr(
 						"duo_user.test", "status", "active"),
+					resource.TestCheckResourceAttrSet(
+						"duo_user.test", "created"),
+					resource.TestCheckResourceAttr(
+						"duo_user.test", "last_login", ""),
+					resource.TestCheckResourceAttr(
+						"duo_user.test", "is_enrolled", "false"),
+					resource.TestCheckResourceAttr(
+						"duo_user.test", "phones.#", "0"),
+					resource.TestCheckResourceAttr(
+						"duo_user.test", "tokens.#", "0"),
+					resource.TestCheckResourceAttr(
+						"duo_user.test", "webauthncredentials.#", "0"),
 				),
 			},
 			resource.TestStep{
@@ -66,6 +82,143 @@ func TestAccUser_Basic(t *testing.T) {
 	})
 }
 
+func TestAccUser_aliases(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckUserConfigAliases(rInt, `"a1-%[1]d", "a2-%[1]d", "a3-%[1]d", "a4-%[1]d", "a5-%[1]d", "a6-%[1]d"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists("duo_user.test"),
+					resource.TestCheckResourceAttr(
+						"duo_user.test", "firstname", "Mister"),
+					resource.TestCheckResourceAttr(
+						"duo_user.test", "lastname", "Sir"),
+					resource.TestCheckResourceAttr(
+						"duo_user.test", "aliases.#", "6"),
+					resource.TestCheckResourceAttr(
+						"duo_user.test", "aliases.5", fmt.Sprintf("a6-%d", rInt)),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckUserConfigAliases(rInt, `"a1-%[1]d", "a2-%[1]d"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists("duo_user.test"),
+					resource.TestCheckResourceAttr(
+						"duo_user.test", "aliases.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config:      testAccCheckUserConfigAliases(rInt, `"a1-%[1]d", "a1-%[1]d"`),
+				ExpectError: regexp.MustCompile("listed more than once"),
+			},
+			resource.TestStep{
+				Config:      testAccCheckUserConfigAliases(rInt, `"1", "2", "3", "4", "5", "6", "7", "8", "9"`),
+				ExpectError: regexp.MustCompile("aliases"),
+			},
+		},
+	})
+}
+
+func TestSetUserAliasParams(t *testing.T) {
+	params := url.Values{}
+	params.Set("alias7", "stale")
+	setUserAliasParams(params, []interface{}{"one", "two"})
+
+	for i, expected := range []string{"one", "two", "", "", "", "", "", ""} {
+		k := fmt.Sprintf("alias%d", i+1)
+		if _, ok := params[k]; !ok {
+			t.Fatalf("expected %s to be set", k)
+		}
+		if actual := params.Get(k); actual != expected {
+			t.Fatalf("expected %s to be %q, got %q", k, expected, actual)
+		}
+	}
+}
+
+func TestAliasConflict(t *testing.T) {
+	users := []admin.User{{UserID: "DU1", Username: "le1f"}}
+
+	cases := []struct {
+		userID   string
+		adopting string
+		conflict bool
+	}{
+		{"", "", true},
+		{"DU1", "", false},
+		{"", "le1f", false},
+		{"", "someone-else", true},
+	}
+	for _, c := range cases {
+		if u := aliasConflict(users, c.userID, c.adopting); (u != nil) != c.conflict {
+			t.Errorf("user %q adopting %q: expected conflict %t, got %+v", c.userID, c.adopting, c.conflict, u)
+		}
+	}
+}
+
+func TestResourceUserCreate_adoptPendingDeletion(t *testing.T) {
+	client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, `{"stat": "OK", "response": [{"user_id": "DU1", "username": "le1f", "status": "pending deletion"}], "metadata": {}}`)
+	})
+	defer closeServer()
+
+	d := resourceUser().TestResourceData()
+	d.Set("username", "le1f")
+	d.Set("adopt_existing", true)
+
+	err := resourceUserCreate(d, client)
+	if err == nil || !strings.Contains(err.Error(), "set restore_from_trash") {
+		t.Fatalf("expected an error asking for restore_from_trash, got %v", err)
+	}
+}
+
+func TestResourceUserDelete_trashAndPurge(t *testing.T) {
+	cases := []struct {
+		users  string
+		purged bool
+	}{
+		{`[]`, true},
+		{`[{"user_id": "DU1", "username": "le1f", "status": "pending deletion"}]`, false},
+	}
+	for _, c := range cases {
+		var deleted bool
+		client, closeServer := testFakeDuoClient(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == "DELETE" && r.URL.Path == "/admin/v1/users/DU1":
+				deleted = true
+				fmt.Fprint(w, `{"stat": "OK", "response": ""}`)
+			case r.Method == "GET" && r.URL.Path == "/admin/v1/users":
+				fmt.Fprint(w, `{"stat": "OK", "response": `+c.users+`, "metadata": {}}`)
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+
+		d := resourceUser().TestResourceData()
+		d.SetId("DU1")
+		d.Set("username", "le1f")
+		d.Set("destroy_behavior", "trash_and_purge")
+		err := resourceUserDelete(d, client)
+		closeServer()
+
+		if !deleted {
+			t.Errorf("users %s: expected DU1 to be deleted", c.users)
+		}
+		if c.purged && err != nil {
+			t.Errorf("users %s: expected the user to be purged, got %v", c.users, err)
+		}
+		if !c.purged && (err == nil || !strings.Contains(err.Error(), "Admin Panel")) {
+			t.Errorf("users %s: expected an error about the user left in the trash, got %v", c.users, err)
+		}
+	}
+}
+
 func TestAccUser_import(t *testing.T) {
 	resourceName := "duo_user.test"
 	rInt := acctest.RandInt()
@@ -81,6 +234,79 @@ func TestAccUser_import(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"attribute_drift",
+					"destroy_behavior",
+					"restore_from_trash",
+					"adopt_existing",
+				},
+			},
+		},
+	})
+}
+
+func TestAccUser_adoptExisting(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				PreConfig: func() {
+					duoclient := testAccProvider.Meta().(*duoapi.DuoApi)
+					duoAdminClient := admin.New(*duoclient)
+					params := url.Values{}
+					params.Set("username", fmt.Sprintf("test-user-%d", rInt))
+					params.Set("realname", "Someone Else")
+					if _, _, err := duoAdminClient.SignedCall("POST", "/admin/v1/users", params, duoapi.UseTimeout); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccCheckUserConfigAdopt(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists("duo_user.test"),
+					resource.TestCheckResourceAttr(
+						"duo_user.test", "realname", "Mister Sir"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUser_destroyDisable(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUserDisabled,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckUserConfigDestroyBehavior(rInt, "disable"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists("duo_user.test"),
+					resource.TestCheckResourceAttr(
+						"duo_user.test", "destroy_behavior", "disable"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUser_destroyTrashAndPurge(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUserPurged,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckUserConfigDestroyBehavior(rInt, "trash_and_purge"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists("duo_user.test"),
+					resource.TestCheckResourceAttr(
+						"duo_user.test", "destroy_behavior", "trash_and_purge"),
+				),
 			},
 		},
 	})
@@ -107,6 +333,63 @@ func testAccCheckUserDestroy(s *terraform.State) error {
 	return nil
 }
 
+// testAccCheckUserPurged checks that users were deleted and that their
+// usernames aren't still held by users left in the trash.
+func testAccCheckUserPurged(s *terraform.State) error {
+	if err := testAccCheckUserDestroy(s); err != nil {
+		return err
+	}
+
+	duoclient := testAccProvider.Meta().(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "duo_user" {
+			continue
+		}
+
+		username := r.Primary.Attributes["username"]
+		user, err := findUserByUsername(duoAdminClient, username)
+		if err != nil {
+			return err
+		}
+		if user != nil {
+			return fmt.Errorf("Expected username %s to be purged, still held by %s", username, user.UserID)
+		}
+	}
+	return nil
+}
+
+// testAccCheckUserDisabled checks that users were disabled rather than
+// deleted, then cleans them up.
+func testAccCheckUserDisabled(s *terraform.State) error {
+	duoclient := testAccProvider.Meta().(*duoapi.DuoApi)
+	duoAdminClient := admin.New(*duoclient)
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "duo_user" {
+			continue
+		}
+
+		result, err := duoAdminClient.GetUser(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if result.Stat != "OK" {
+			return fmt.Errorf("Could not find disabled user %s %s", result.Stat, *result.Message)
+		}
+		if result.Response.Status != "disabled" {
+			return fmt.Errorf("Expected user %s to be disabled, got %s", r.Primary.ID, result.Response.Status)
+		}
+
+		_, _, err = duoAdminClient.SignedCall("DELETE", fmt.Sprintf("/admin/v1/users/%s", r.Primary.ID), nil, duoapi.UseTimeout)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func testAccCheckUserExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -164,3 +447,79 @@ resource "duo_user" "test" {
 }
 `, rInt)
 }
+
+func testAccCheckUserConfigAliases(rInt int, aliases string) string {
+	return fmt.Sprintf(`
+resource "duo_user" "test" {
+  username = "test-user-%[1]d"
+  firstname = "Mister"
+  lastname = "Sir"
+  aliases = [`+aliases+`]
+}
+`, rInt)
+}
+
+func testAccCheckUserConfigDestroyBehavior(rInt int, behavior string) string {
+	return fmt.Sprintf(`
+resource "duo_user" "test" {
+  username = "test-user-%d"
+  destroy_behavior = "%s"
+}
+`, rInt, behavior)
+}
+
+func testAccCheckUserConfigAdopt(rInt int) string {
+	return fmt.Sprintf(`
+resource "duo_user" "test" {
+  username = "test-user-%d"
+  realname = "Mister Sir"
+  adopt_existing = true
+}
+`, rInt)
+}
+
+func TestAccUser_phones(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckUserConfigPhone(rInt),
+			},
+			resource.TestStep{
+				// The association is made after the user is read, so the
+				// phone only shows up on the next refresh
+				Config: testAccCheckUserConfigPhone(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"duo_user.test", "phones.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"duo_user.test", "phones.0.phone_id", "duo_phone.test", "id"),
+					resource.TestCheckResourceAttr(
+						"duo_user.test", "phones.0.number", "+18005551234"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUserConfigPhone(rInt int) string {
+	return fmt.Sprintf(`
+resource "duo_user" "test" {
+  username = "test-user-%d"
+}
+
+resource "duo_phone" "test" {
+  number = "+18005551234"
+  type = "Mobile"
+  platform = "Unknown"
+}
+
+resource "duo_user_phone_association" "test" {
+  user_id = "${duo_user.test.id}"
+  phone_id = "${duo_phone.test.id}"
+}
+`, rInt)
+}
@@ -0,0 +1,105 @@
+package duo
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// validateRFC3339 ensures a string attribute holds an RFC3339 timestamp.
+func validateRFC3339(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be an RFC3339 timestamp: %s", k, err))
+	}
+	return
+}
+
+// timeToMillis converts an RFC3339 timestamp into the unix millisecond
+// value expected by the Duo API.
+func timeToMillis(s string) (string, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(unixMillis(t), 10), nil
+}
+
+func unixMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// millisToTime converts a unix millisecond value returned by the Duo API
+// into an RFC3339 timestamp.
+func millisToTime(ms int64) string {
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+}
+
+// secondsToTime converts a unix timestamp in seconds returned by the Duo
+// API into an RFC3339 timestamp.
+func secondsToTime(s int64) string {
+	return time.Unix(s, 0).UTC().Format(time.RFC3339)
+}
+
+// optionalSecondsToTime converts an optional unix timestamp in seconds
+// into an RFC3339 timestamp, or an empty string when it isn't set.
+func optionalSecondsToTime(s *uint64) string {
+	if s == nil {
+		return ""
+	}
+	return secondsToTime(int64(*s))
+}
+
+// validatePositiveInt ensures an integer attribute is greater than zero.
+func validatePositiveInt(v interface{}, k string) (ws []string, errors []error) {
+	if v.(int) < 1 {
+		errors = append(errors, fmt.Errorf("%q must be greater than zero, got %d", k, v.(int)))
+	}
+	return
+}
+
+// validateStringInSlice returns a validation function ensuring a string
+// attribute holds one of the valid values.
+func validateStringInSlice(valid []string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		for _, s := range valid {
+			if v.(string) == s {
+				return
+			}
+		}
+		errors = append(errors, fmt.Errorf("%q must be one of %v, got %q", k, valid, v.(string)))
+		return
+	}
+}
+
+// stringSetDiff returns the values in desired missing from current, and the
+// values in current that aren't in desired.
+func stringSetDiff(current, desired []string) (add, remove []string) {
+	have := make(map[string]bool, len(current))
+	for _, v := range current {
+		have[v] = true
+	}
+	want := make(map[string]bool, len(desired))
+	for _, v := range desired {
+		want[v] = true
+		if !have[v] {
+			add = append(add, v)
+		}
+	}
+	for _, v := range current {
+		if !want[v] {
+			remove = append(remove, v)
+		}
+	}
+	return add, remove
+}
+
+// expandStringSet returns the members of a set of strings.
+func expandStringSet(s *schema.Set) []string {
+	var values []string
+	for _, v := range s.List() {
+		values = append(values, v.(string))
+	}
+	return values
+}
@@ -0,0 +1,26 @@
+package duo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStringSetDiff(t *testing.T) {
+	cases := []struct {
+		current, desired []string
+		add, remove      []string
+	}{
+		{nil, nil, nil, nil},
+		{nil, []string{"a", "b"}, []string{"a", "b"}, nil},
+		{[]string{"a", "b"}, nil, nil, []string{"a", "b"}},
+		{[]string{"a", "b"}, []string{"b", "c"}, []string{"c"}, []string{"a"}},
+		{[]string{"a", "b"}, []string{"b", "a"}, nil, nil},
+	}
+
+	for _, c := range cases {
+		add, remove := stringSetDiff(c.current, c.desired)
+		if !reflect.DeepEqual(add, c.add) || !reflect.DeepEqual(remove, c.remove) {
+			t.Errorf("stringSetDiff(%v, %v) = %v, %v; expected %v, %v", c.current, c.desired, add, remove, c.add, c.remove)
+		}
+	}
+}